@@ -0,0 +1,336 @@
+// Package batch provides a Batcher aggregating blobs added by many goroutines into submissions to DA.
+package batch
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"time"
+
+	"github.com/rollkit/go-da"
+)
+
+const (
+	// DefaultMaxBatchSize is the default max total size of blobs submitted in single call.
+	DefaultMaxBatchSize = 1 << 20
+	// DefaultFlushInterval is the default max time blob waits in a batch before submission.
+	DefaultFlushInterval = time.Second
+	// DefaultQueueSize is the default max number of blobs waiting for the batch.
+	DefaultQueueSize = 1024
+	// DefaultSubmitTimeout is the default max duration of single submission.
+	DefaultSubmitTimeout = time.Minute
+)
+
+// ErrClosed is returned by Add and Flush after Batcher is closed.
+var ErrClosed = errors.New("batcher is closed")
+
+// Future holds the result of submission of single blob.
+type Future struct {
+	done chan struct{}
+	id   da.ID
+	err  error
+}
+
+// Done returns a channel closed when the result is available.
+func (f *Future) Done() <-chan struct{} {
+	return f.done
+}
+
+// Result returns ID of submitted blob, or submission error. It must be called after Done is closed.
+func (f *Future) Result() (da.ID, error) {
+	return f.id, f.err
+}
+
+// Wait waits until the result is available or ctx is done.
+func (f *Future) Wait(ctx context.Context) (da.ID, error) {
+	select {
+	case <-f.done:
+		return f.Result()
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	}
+}
+
+type item struct {
+	blob   da.Blob
+	future *Future
+}
+
+// Batcher packs blobs into submissions to DA, in given namespace.
+//
+// Batch is submitted when total size of its blobs reaches max batch size, or after flush interval since the first
+// blob was added. Blob larger than max batch size is submitted alone. Submissions are made one at a time; Add blocks
+// while the queue of waiting blobs is full, providing backpressure. Submission not finished within submit timeout is
+// abandoned, failing futures of its blobs, so a hung DA doesn't block Flush and Close forever.
+type Batcher struct {
+	target        da.DA
+	ns            da.Namespace
+	gasPrice      float64
+	options       []byte
+	maxBatchSize  uint64
+	flushInterval time.Duration
+	submitTimeout time.Duration
+
+	queue   chan *item
+	flushes chan chan struct{}
+	stop    chan struct{}
+	done    chan struct{}
+
+	mu          sync.RWMutex
+	closed      bool
+	maxBlobSize uint64
+}
+
+// New creates new Batcher submitting blobs to target DA in given namespace, and starts processing.
+func New(target da.DA, ns da.Namespace, opts ...func(*Batcher) *Batcher) *Batcher {
+	b := &Batcher{
+		target:        target,
+		ns:            ns,
+		maxBatchSize:  DefaultMaxBatchSize,
+		flushInterval: DefaultFlushInterval,
+		submitTimeout: DefaultSubmitTimeout,
+		queue:         make(chan *item, DefaultQueueSize),
+		flushes:       make(chan chan struct{}),
+		stop:          make(chan struct{}),
+		done:          make(chan struct{}),
+	}
+	for _, opt := range opts {
+		b = opt(b)
+	}
+	go b.run()
+	return b
+}
+
+// WithMaxBatchSize configures the max total size of blobs submitted in single call.
+func WithMaxBatchSize(maxBatchSize uint64) func(*Batcher) *Batcher {
+	return func(b *Batcher) *Batcher {
+		b.maxBatchSize = maxBatchSize
+		return b
+	}
+}
+
+// WithFlushInterval configures the max time blob waits in a batch before submission.
+func WithFlushInterval(interval time.Duration) func(*Batcher) *Batcher {
+	return func(b *Batcher) *Batcher {
+		b.flushInterval = interval
+		return b
+	}
+}
+
+// WithSubmitTimeout configures the max duration of single submission. Futures of blobs in submission not finished in
+// time fail with context.DeadlineExceeded.
+func WithSubmitTimeout(timeout time.Duration) func(*Batcher) *Batcher {
+	return func(b *Batcher) *Batcher {
+		b.submitTimeout = timeout
+		return b
+	}
+}
+
+// WithQueueSize configures the max number of blobs waiting for the batch, before Add blocks.
+func WithQueueSize(size int) func(*Batcher) *Batcher {
+	return func(b *Batcher) *Batcher {
+		b.queue = make(chan *item, size)
+		return b
+	}
+}
+
+// WithGasPrice configures the gas price used for submissions.
+func WithGasPrice(gasPrice float64) func(*Batcher) *Batcher {
+	return func(b *Batcher) *Batcher {
+		b.gasPrice = gasPrice
+		return b
+	}
+}
+
+// WithSubmitOptions configures options passed to SubmitWithOptions. By default, Submit is used.
+func WithSubmitOptions(options []byte) func(*Batcher) *Batcher {
+	return func(b *Batcher) *Batcher {
+		b.options = options
+		return b
+	}
+}
+
+// Add adds blob to the batch, returning Future holding its ID once submitted. Blobs larger than MaxBlobSize of DA
+// are rejected with da.ErrBlobSizeOverLimit. If the queue is full, Add blocks until there is space or ctx is done.
+func (b *Batcher) Add(ctx context.Context, blob da.Blob) (*Future, error) {
+	maxBlobSize, err := b.getMaxBlobSize(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if uint64(len(blob)) > maxBlobSize {
+		return nil, &da.ErrBlobSizeOverLimit{}
+	}
+
+	b.mu.RLock()
+	defer b.mu.RUnlock()
+	if b.closed {
+		return nil, ErrClosed
+	}
+	f := &Future{done: make(chan struct{})}
+	select {
+	case b.queue <- &item{blob: blob, future: f}:
+		return f, nil
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	}
+}
+
+// Flush submits all blobs added so far, and waits until submissions are done or ctx is done.
+func (b *Batcher) Flush(ctx context.Context) error {
+	b.mu.RLock()
+	defer b.mu.RUnlock()
+	if b.closed {
+		return ErrClosed
+	}
+	flushed := make(chan struct{})
+	select {
+	case b.flushes <- flushed:
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+	select {
+	case <-flushed:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+// Close stops accepting new blobs, submits all blobs added so far and waits until submissions are done.
+func (b *Batcher) Close() error {
+	b.mu.Lock()
+	if b.closed {
+		b.mu.Unlock()
+		return nil
+	}
+	b.closed = true
+	b.mu.Unlock()
+
+	close(b.stop)
+	<-b.done
+	return nil
+}
+
+// getMaxBlobSize returns MaxBlobSize of DA, fetched once.
+func (b *Batcher) getMaxBlobSize(ctx context.Context) (uint64, error) {
+	b.mu.RLock()
+	maxBlobSize := b.maxBlobSize
+	b.mu.RUnlock()
+	if maxBlobSize != 0 {
+		return maxBlobSize, nil
+	}
+
+	maxBlobSize, err := b.target.MaxBlobSize(ctx)
+	if err != nil {
+		return 0, err
+	}
+	b.mu.Lock()
+	b.maxBlobSize = maxBlobSize
+	b.mu.Unlock()
+	return maxBlobSize, nil
+}
+
+func (b *Batcher) run() {
+	defer close(b.done)
+
+	var (
+		pending []*item
+		size    uint64
+	)
+	timer := time.NewTimer(b.flushInterval)
+	timer.Stop()
+	flush := func() {
+		if !timer.Stop() {
+			// drain tick of expired timer, unless it was already received
+			select {
+			case <-timer.C:
+			default:
+			}
+		}
+		if len(pending) > 0 {
+			b.submit(pending)
+		}
+		pending, size = nil, 0
+	}
+	add := func(it *item) {
+		if len(pending) > 0 && size+uint64(len(it.blob)) > b.maxBatchSize {
+			flush()
+		}
+		pending = append(pending, it)
+		size += uint64(len(it.blob))
+		if len(pending) == 1 {
+			timer.Reset(b.flushInterval)
+		}
+		if size >= b.maxBatchSize {
+			flush()
+		}
+	}
+
+	for {
+		select {
+		case it := <-b.queue:
+			add(it)
+		case <-timer.C:
+			flush()
+		case flushed := <-b.flushes:
+			// blobs queued before Flush was called are included
+			for n := len(b.queue); n > 0; n-- {
+				add(<-b.queue)
+			}
+			flush()
+			close(flushed)
+		case <-b.stop:
+			// Close waits for pending Add calls, so the queue can't grow anymore
+			for n := len(b.queue); n > 0; n-- {
+				add(<-b.queue)
+			}
+			flush()
+			return
+		}
+	}
+}
+
+// submit submits blobs of items in single call, and completes their futures.
+func (b *Batcher) submit(items []*item) {
+	blobs := make([]da.Blob, len(items))
+	for i, it := range items {
+		blobs[i] = it.blob
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), b.submitTimeout)
+	defer cancel()
+	type result struct {
+		ids []da.ID
+		err error
+	}
+	// DA not respecting ctx is abandoned, so it can't block the run loop
+	results := make(chan result, 1)
+	go func() {
+		var r result
+		if b.options != nil {
+			r.ids, r.err = b.target.SubmitWithOptions(ctx, blobs, b.gasPrice, b.ns, b.options)
+		} else {
+			r.ids, r.err = b.target.Submit(ctx, blobs, b.gasPrice, b.ns)
+		}
+		results <- r
+	}()
+	var ids []da.ID
+	var err error
+	select {
+	case r := <-results:
+		ids, err = r.ids, r.err
+	case <-ctx.Done():
+		err = ctx.Err()
+	}
+	if err == nil && len(ids) != len(items) {
+		err = errors.New("unexpected number of IDs returned by DA")
+	}
+	for i, it := range items {
+		if err != nil {
+			it.future.err = err
+		} else {
+			it.future.id = ids[i]
+		}
+		close(it.future.done)
+	}
+}
@@ -0,0 +1,156 @@
+package batch_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/rollkit/go-da"
+	"github.com/rollkit/go-da/batch"
+	"github.com/rollkit/go-da/test"
+)
+
+var ns = da.Namespace("ns")
+
+func TestBatcher(t *testing.T) {
+	ctx := context.TODO()
+	dummy := test.NewDummyDA()
+	b := batch.New(dummy, ns, batch.WithMaxBatchSize(1000), batch.WithFlushInterval(time.Hour))
+
+	// 100 blobs of 100 bytes, added concurrently, are packed into 10 submissions
+	futures := make([]*batch.Future, 100)
+	blobs := make([]da.Blob, len(futures))
+	var wg sync.WaitGroup
+	for i := range futures {
+		blobs[i] = []byte(fmt.Sprintf("%0100d", i))
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			var err error
+			futures[i], err = b.Add(ctx, blobs[i])
+			assert.NoError(t, err)
+		}(i)
+	}
+	wg.Wait()
+	require.NoError(t, b.Flush(ctx))
+
+	for i, f := range futures {
+		id, err := f.Wait(ctx)
+		require.NoError(t, err)
+		ret, err := dummy.Get(ctx, []da.ID{id}, ns)
+		require.NoError(t, err)
+		assert.Equal(t, []da.Blob{blobs[i]}, ret)
+	}
+	height, err := dummy.LatestHeight(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, uint64(10), height)
+	require.NoError(t, b.Close())
+}
+
+func TestBatcherFlushInterval(t *testing.T) {
+	ctx := context.TODO()
+	b := batch.New(test.NewDummyDA(), ns, batch.WithFlushInterval(10*time.Millisecond))
+	defer b.Close() //nolint:errcheck
+
+	f, err := b.Add(ctx, []byte("blob"))
+	require.NoError(t, err)
+	select {
+	case <-f.Done():
+	case <-time.After(time.Second):
+		t.Fatal("batch was not flushed")
+	}
+	id, err := f.Result()
+	assert.NoError(t, err)
+	assert.NotEmpty(t, id)
+}
+
+func TestBatcherBackpressure(t *testing.T) {
+	ctx := context.TODO()
+	target := &blockingDA{DummyDA: test.NewDummyDA(), unblock: make(chan struct{})}
+	b := batch.New(target, ns, batch.WithMaxBatchSize(4), batch.WithQueueSize(1))
+
+	// first blob fills the batch and blocks on submission, second one fills the queue
+	first, err := b.Add(ctx, []byte("blob"))
+	require.NoError(t, err)
+	second, err := b.Add(ctx, []byte("blob"))
+	require.NoError(t, err)
+
+	timeoutCtx, cancel := context.WithTimeout(ctx, 50*time.Millisecond)
+	defer cancel()
+	_, err = b.Add(timeoutCtx, []byte("blob"))
+	assert.ErrorIs(t, err, context.DeadlineExceeded)
+
+	close(target.unblock)
+	for _, f := range []*batch.Future{first, second} {
+		_, err := f.Wait(ctx)
+		assert.NoError(t, err)
+	}
+	require.NoError(t, b.Close())
+}
+
+func TestBatcherErrors(t *testing.T) {
+	ctx := context.TODO()
+	target := &blockingDA{DummyDA: test.NewDummyDA(test.WithMaxBlobSize(10)), err: errors.New("submission failed")}
+	b := batch.New(target, ns, batch.WithFlushInterval(time.Hour))
+
+	_, err := b.Add(ctx, make([]byte, 11))
+	assert.ErrorIs(t, err, &da.ErrBlobSizeOverLimit{})
+
+	// submission error is returned by all futures; pending blobs are submitted on Close
+	first, err := b.Add(ctx, []byte("first"))
+	require.NoError(t, err)
+	second, err := b.Add(ctx, []byte("second"))
+	require.NoError(t, err)
+	require.NoError(t, b.Close())
+	for _, f := range []*batch.Future{first, second} {
+		_, err := f.Result()
+		assert.ErrorIs(t, err, target.err)
+	}
+
+	_, err = b.Add(ctx, []byte("blob"))
+	assert.ErrorIs(t, err, batch.ErrClosed)
+	assert.ErrorIs(t, b.Flush(ctx), batch.ErrClosed)
+}
+
+func TestBatcherSubmitTimeout(t *testing.T) {
+	ctx := context.TODO()
+	// Submit never returns, ignoring ctx
+	target := &blockingDA{DummyDA: test.NewDummyDA(), unblock: make(chan struct{})}
+	defer close(target.unblock)
+	b := batch.New(target, ns, batch.WithFlushInterval(time.Hour), batch.WithSubmitTimeout(50*time.Millisecond))
+
+	f, err := b.Add(ctx, []byte("blob"))
+	require.NoError(t, err)
+	require.NoError(t, b.Flush(ctx))
+	_, err = f.Result()
+	assert.ErrorIs(t, err, context.DeadlineExceeded)
+
+	f, err = b.Add(ctx, []byte("blob"))
+	require.NoError(t, err)
+	require.NoError(t, b.Close())
+	_, err = f.Result()
+	assert.ErrorIs(t, err, context.DeadlineExceeded)
+}
+
+// blockingDA is a DummyDA with Submit blocking until unblock is closed (if set), failing with err (if set).
+type blockingDA struct {
+	*test.DummyDA
+	unblock chan struct{}
+	err     error
+}
+
+func (d *blockingDA) Submit(ctx context.Context, blobs []da.Blob, gasPrice float64, ns da.Namespace) ([]da.ID, error) {
+	if d.unblock != nil {
+		<-d.unblock
+	}
+	if d.err != nil {
+		return nil, d.err
+	}
+	return d.DummyDA.Submit(ctx, blobs, gasPrice, ns)
+}
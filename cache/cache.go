@@ -0,0 +1,185 @@
+// Package cache provides a da.DA wrapper caching blobs and IDs of past heights.
+package cache
+
+import (
+	"context"
+	"encoding/binary"
+	"fmt"
+	"sync"
+
+	"github.com/rollkit/go-da"
+)
+
+// DefaultMaxBytes is the default bound of total size of cached entries.
+const DefaultMaxBytes = 64 << 20
+
+// Stats holds statistics of Cache.
+type Stats struct {
+	BlobHits   uint64
+	BlobMisses uint64
+	IDsHits    uint64
+	IDsMisses  uint64
+	Evictions  uint64
+	Entries    int
+	Bytes      uint64
+}
+
+// Cache is a da.DA wrapper caching results of Get (by namespace and ID) and GetIDs (by namespace and height) in a
+// LRU cache bounded by total size of entries.
+//
+// Only successful results are cached, so ErrFutureHeight (or any other error) is never cached. GetIDs results are
+// cached for every produced height, including heights without blobs in the namespace, as they never change after the
+// height is produced. Cached slices are shared between callers and must not be modified.
+//
+// Cache implements da.DA only, so da.GetAll on Cache is built from cached GetIDs and Get results, instead of
+// bypassing the cache. Other optional interfaces concern the tip of DA or pending submissions, which are never
+// cached, so they are to be used on wrapped DA directly.
+type Cache struct {
+	target da.DA
+
+	mu    sync.Mutex // protects lru and stats
+	lru   *lru
+	stats Stats
+}
+
+var _ da.DA = &Cache{}
+
+// New creates new Cache wrapping target DA, bounded by given total size of cached entries.
+func New(target da.DA, maxBytes uint64) *Cache {
+	return &Cache{target: target, lru: newLRU(maxBytes)}
+}
+
+// Stats returns hit/miss statistics and current size of the cache.
+func (c *Cache) Stats() Stats {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	stats := c.stats
+	stats.Evictions = c.lru.evictions
+	stats.Entries = len(c.lru.items)
+	stats.Bytes = c.lru.bytes
+	return stats
+}
+
+// Purge removes all cached entries.
+func (c *Cache) Purge() {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.lru.purge()
+}
+
+// MaxBlobSize returns the max blob size of wrapped DA.
+func (c *Cache) MaxBlobSize(ctx context.Context) (uint64, error) {
+	return c.target.MaxBlobSize(ctx)
+}
+
+// Get returns Blobs for given IDs. Only blobs missing in cache are requested from wrapped DA.
+func (c *Cache) Get(ctx context.Context, ids []da.ID, ns da.Namespace) ([]da.Blob, error) {
+	blobs := make([]da.Blob, len(ids))
+	var missing []int
+	c.mu.Lock()
+	for i, id := range ids {
+		if blob, ok := c.lru.get(blobKey(ns, id)); ok {
+			blobs[i] = blob.(da.Blob)
+			c.stats.BlobHits++
+		} else {
+			missing = append(missing, i)
+			c.stats.BlobMisses++
+		}
+	}
+	c.mu.Unlock()
+	if len(missing) == 0 {
+		return blobs, nil
+	}
+
+	missingIDs := make([]da.ID, len(missing))
+	for i, idx := range missing {
+		missingIDs[i] = ids[idx]
+	}
+	fetched, err := c.target.Get(ctx, missingIDs, ns)
+	if err != nil {
+		return nil, err
+	}
+	if len(fetched) != len(missing) {
+		return nil, fmt.Errorf("wrapped DA returned %d blobs for %d IDs", len(fetched), len(missing))
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	for i, idx := range missing {
+		blobs[idx] = fetched[i]
+		c.lru.add(blobKey(ns, ids[idx]), fetched[i], uint64(len(fetched[i])))
+	}
+	return blobs, nil
+}
+
+// GetIDs returns IDs of Blobs at given height.
+func (c *Cache) GetIDs(ctx context.Context, height uint64, ns da.Namespace) (*da.GetIDsResult, error) {
+	key := idsKey(ns, height)
+	c.mu.Lock()
+	if ret, ok := c.lru.get(key); ok {
+		c.stats.IDsHits++
+		c.mu.Unlock()
+		return ret.(*da.GetIDsResult), nil
+	}
+	c.stats.IDsMisses++
+	c.mu.Unlock()
+
+	ret, err := c.target.GetIDs(ctx, height, ns)
+	if err != nil || ret == nil {
+		return ret, err
+	}
+
+	size := uint64(24) // timestamp
+	for _, id := range ret.IDs {
+		size += uint64(len(id))
+	}
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.lru.add(key, ret, size)
+	return ret, nil
+}
+
+// GetProofs returns inclusion Proofs for given IDs.
+func (c *Cache) GetProofs(ctx context.Context, ids []da.ID, ns da.Namespace) ([]da.Proof, error) {
+	return c.target.GetProofs(ctx, ids, ns)
+}
+
+// Commit returns Commitments for given blobs.
+func (c *Cache) Commit(ctx context.Context, blobs []da.Blob, ns da.Namespace) ([]da.Commitment, error) {
+	return c.target.Commit(ctx, blobs, ns)
+}
+
+// Submit submits blobs to wrapped DA.
+func (c *Cache) Submit(ctx context.Context, blobs []da.Blob, gasPrice float64, ns da.Namespace) ([]da.ID, error) {
+	return c.target.Submit(ctx, blobs, gasPrice, ns)
+}
+
+// SubmitWithOptions submits blobs to wrapped DA.
+func (c *Cache) SubmitWithOptions(ctx context.Context, blobs []da.Blob, gasPrice float64, ns da.Namespace, options []byte) ([]da.ID, error) {
+	return c.target.SubmitWithOptions(ctx, blobs, gasPrice, ns, options)
+}
+
+// Validate validates Proofs for given IDs.
+func (c *Cache) Validate(ctx context.Context, ids []da.ID, proofs []da.Proof, ns da.Namespace) ([]bool, error) {
+	return c.target.Validate(ctx, ids, proofs, ns)
+}
+
+// blobKey returns cache key of blob with given namespace and ID.
+func blobKey(ns da.Namespace, id da.ID) string {
+	return key('b', ns, id)
+}
+
+// idsKey returns cache key of GetIDs result for given namespace and height.
+func idsKey(ns da.Namespace, height uint64) string {
+	return key('i', ns, binary.BigEndian.AppendUint64(nil, height))
+}
+
+// key returns unambiguous concatenation of kind, namespace and suffix.
+func key(kind byte, ns da.Namespace, suffix []byte) string {
+	b := make([]byte, 0, 1+binary.MaxVarintLen64+len(ns)+len(suffix))
+	b = append(b, kind)
+	b = binary.AppendUvarint(b, uint64(len(ns)))
+	b = append(b, ns...)
+	b = append(b, suffix...)
+	return string(b)
+}
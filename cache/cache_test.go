@@ -0,0 +1,131 @@
+package cache_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/rollkit/go-da"
+	"github.com/rollkit/go-da/cache"
+	"github.com/rollkit/go-da/mocks"
+	"github.com/rollkit/go-da/test"
+)
+
+func TestCache(t *testing.T) {
+	test.RunDATestSuite(t, cache.New(test.NewDummyDA(), cache.DefaultMaxBytes))
+}
+
+func TestCacheGet(t *testing.T) {
+	ctx := context.TODO()
+	ns := da.Namespace("ns")
+	ids := []da.ID{[]byte("id1"), []byte("id2")}
+	blobs := []da.Blob{[]byte("blob1"), []byte("blob2")}
+
+	mockDA := mocks.NewMockDA(t)
+	mockDA.EXPECT().Get(ctx, ids[:1], ns).Return(blobs[:1], nil).Once()
+	mockDA.EXPECT().Get(ctx, ids[1:], ns).Return(blobs[1:], nil).Once()
+	mockDA.EXPECT().Get(ctx, ids[:1], da.Namespace("other")).Return(nil, &da.ErrBlobNotFound{}).Once()
+
+	c := cache.New(mockDA, cache.DefaultMaxBytes)
+	ret, err := c.Get(ctx, ids[:1], ns)
+	require.NoError(t, err)
+	assert.Equal(t, blobs[:1], ret)
+
+	// only missing blob is fetched
+	ret, err = c.Get(ctx, ids, ns)
+	require.NoError(t, err)
+	assert.Equal(t, blobs, ret)
+
+	ret, err = c.Get(ctx, ids, ns)
+	require.NoError(t, err)
+	assert.Equal(t, blobs, ret)
+
+	// namespaces are isolated
+	_, err = c.Get(ctx, ids[:1], da.Namespace("other"))
+	assert.ErrorIs(t, err, &da.ErrBlobNotFound{})
+
+	stats := c.Stats()
+	assert.Equal(t, uint64(3), stats.BlobHits)
+	assert.Equal(t, uint64(3), stats.BlobMisses)
+	assert.Equal(t, 2, stats.Entries)
+
+	// blobs missing in response of wrapped DA are not reported as found
+	mockDA.EXPECT().Get(ctx, ids, da.Namespace("short")).Return(blobs[:1], nil).Once()
+	ret, err = c.Get(ctx, ids, da.Namespace("short"))
+	assert.Error(t, err)
+	assert.Nil(t, ret)
+	assert.Equal(t, 2, c.Stats().Entries)
+}
+
+func TestCacheGetIDs(t *testing.T) {
+	ctx := context.TODO()
+	ns := da.Namespace("ns")
+	result := &da.GetIDsResult{IDs: []da.ID{[]byte("id1")}, Timestamp: time.Now()}
+
+	mockDA := mocks.NewMockDA(t)
+	mockDA.EXPECT().GetIDs(ctx, uint64(1), ns).Return(result, nil).Once()
+	mockDA.EXPECT().GetIDs(ctx, uint64(2), ns).Return(nil, &da.ErrFutureHeight{}).Twice()
+	mockDA.EXPECT().GetIDs(ctx, uint64(0), ns).Return(nil, nil).Twice()
+
+	c := cache.New(mockDA, cache.DefaultMaxBytes)
+	for i := 0; i < 2; i++ {
+		ret, err := c.GetIDs(ctx, 1, ns)
+		require.NoError(t, err)
+		assert.Equal(t, result, ret)
+
+		ret, err = c.GetIDs(ctx, 2, ns)
+		assert.ErrorIs(t, err, &da.ErrFutureHeight{})
+		assert.Nil(t, ret)
+
+		ret, err = c.GetIDs(ctx, 0, ns)
+		assert.NoError(t, err)
+		assert.Nil(t, ret)
+	}
+
+	stats := c.Stats()
+	assert.Equal(t, uint64(1), stats.IDsHits)
+	assert.Equal(t, uint64(5), stats.IDsMisses)
+	assert.Equal(t, 1, stats.Entries)
+}
+
+func TestCacheEviction(t *testing.T) {
+	ctx := context.TODO()
+	ns := da.Namespace("ns")
+	ids := []da.ID{[]byte("id0"), []byte("id1"), []byte("id2"), []byte("big")}
+	blobs := []da.Blob{make([]byte, 40), make([]byte, 40), make([]byte, 40), make([]byte, 200)}
+
+	mockDA := mocks.NewMockDA(t)
+	for i := range ids {
+		mockDA.EXPECT().Get(ctx, ids[i:i+1], ns).Return(blobs[i:i+1], nil)
+	}
+
+	// every blob entry takes 47 bytes (including key), so at most 2 blobs fit in the cache
+	c := cache.New(mockDA, 100)
+	get := func(i int) {
+		ret, err := c.Get(ctx, ids[i:i+1], ns)
+		require.NoError(t, err)
+		assert.Equal(t, blobs[i:i+1], ret)
+	}
+
+	get(0)
+	get(1)
+	get(0)
+	get(2) // evicts id1, as least recently used
+	get(0)
+	get(1) // evicts id2
+	get(3) // too big to be cached
+
+	stats := c.Stats()
+	assert.Equal(t, uint64(2), stats.BlobHits)
+	assert.Equal(t, uint64(5), stats.BlobMisses)
+	assert.Equal(t, uint64(2), stats.Evictions)
+	assert.Equal(t, 2, stats.Entries)
+	assert.Equal(t, uint64(94), stats.Bytes)
+
+	c.Purge()
+	assert.Equal(t, 0, c.Stats().Entries)
+	assert.Equal(t, uint64(0), c.Stats().Bytes)
+}
@@ -0,0 +1,63 @@
+package cache
+
+import "container/list"
+
+// lru is a least recently used cache, bounded by total size of entries. It's not safe for concurrent use.
+type lru struct {
+	maxBytes  uint64
+	bytes     uint64
+	evictions uint64
+	ll        *list.List
+	items     map[string]*list.Element
+}
+
+type entry struct {
+	key   string
+	value interface{}
+	size  uint64
+}
+
+func newLRU(maxBytes uint64) *lru {
+	return &lru{maxBytes: maxBytes, ll: list.New(), items: make(map[string]*list.Element)}
+}
+
+// get returns value stored under key and marks it as recently used.
+func (c *lru) get(key string) (interface{}, bool) {
+	elem, ok := c.items[key]
+	if !ok {
+		return nil, false
+	}
+	c.ll.MoveToFront(elem)
+	return elem.Value.(*entry).value, true
+}
+
+// add stores value under key, evicting least recently used entries if needed. Values larger than the bound are
+// not stored.
+func (c *lru) add(key string, value interface{}, size uint64) {
+	size += uint64(len(key))
+	if size > c.maxBytes {
+		return
+	}
+	if elem, ok := c.items[key]; ok {
+		c.remove(elem)
+	}
+	c.items[key] = c.ll.PushFront(&entry{key: key, value: value, size: size})
+	c.bytes += size
+	for c.bytes > c.maxBytes {
+		c.remove(c.ll.Back())
+		c.evictions++
+	}
+}
+
+func (c *lru) remove(elem *list.Element) {
+	e := c.ll.Remove(elem).(*entry)
+	delete(c.items, e.key)
+	c.bytes -= e.size
+}
+
+// purge removes all entries.
+func (c *lru) purge() {
+	c.ll.Init()
+	c.items = make(map[string]*list.Element)
+	c.bytes = 0
+}
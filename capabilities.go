@@ -0,0 +1,86 @@
+package da
+
+import (
+	"context"
+	"time"
+)
+
+// Names of optional interfaces reported in Capabilities.Extensions.
+const (
+	ExtensionSubscriber         = "Subscriber"
+	ExtensionLatestHeightGetter = "LatestHeightGetter"
+	ExtensionAllGetter          = "AllGetter"
+	ExtensionSubmissionTracker  = "SubmissionTracker"
+	ExtensionGasEstimator       = "GasEstimator"
+)
+
+// CapabilitiesProvider is an optional interface implemented by DA layers able to describe supported features.
+// Use GetCapabilities function to support DA layers not implementing it.
+type CapabilitiesProvider interface {
+	// Capabilities returns the set of features supported by DA.
+	Capabilities(ctx context.Context) (*Capabilities, error)
+}
+
+// Capabilities describes features supported by DA layer.
+type Capabilities struct {
+	// NamespaceSize is the size of namespace (in bytes) expected by DA, or 0 if namespaces of any size are accepted.
+	NamespaceSize uint32
+	// OptionsSchema is a JSON schema of options accepted by SubmitWithOptions, or empty if options are ignored.
+	OptionsSchema string
+	// ProofType identifies the kind of Proofs returned by GetProofs, or is empty if proofs are not supported.
+	ProofType string
+	// MaxBlobSize is the max blob size, as returned by MaxBlobSize.
+	MaxBlobSize uint64
+	// BlockTime is the expected time between consecutive heights, or 0 if unknown.
+	BlockTime time.Duration
+	// Extensions lists names of optional interfaces implemented by DA.
+	Extensions []string
+}
+
+// GetCapabilities returns the set of features supported by d.
+//
+// If d implements CapabilitiesProvider, the call is forwarded to it. Otherwise, capabilities are limited to max blob
+// size and optional interfaces implemented by d.
+func GetCapabilities(ctx context.Context, d DA) (*Capabilities, error) {
+	if provider, ok := d.(CapabilitiesProvider); ok {
+		return provider.Capabilities(ctx)
+	}
+
+	maxBlobSize, err := d.MaxBlobSize(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return &Capabilities{MaxBlobSize: maxBlobSize, Extensions: SupportedExtensions(d)}, nil
+}
+
+// Unwrapper is an optional interface implemented by DA wrappers exposing every optional interface, regardless of
+// wrapped DA: calls of methods not implemented by wrapped DA fail with ErrNotSupported.
+type Unwrapper interface {
+	// Unwrap returns the wrapped DA.
+	Unwrap() DA
+}
+
+// SupportedExtensions returns names of optional interfaces implemented by d. For wrappers implementing Unwrapper,
+// optional interfaces implemented by the innermost wrapped DA are returned.
+func SupportedExtensions(d DA) []string {
+	for u, ok := d.(Unwrapper); ok; u, ok = d.(Unwrapper) {
+		d = u.Unwrap()
+	}
+	extensions := []string{}
+	if _, ok := d.(Subscriber); ok {
+		extensions = append(extensions, ExtensionSubscriber)
+	}
+	if _, ok := d.(LatestHeightGetter); ok {
+		extensions = append(extensions, ExtensionLatestHeightGetter)
+	}
+	if _, ok := d.(AllGetter); ok {
+		extensions = append(extensions, ExtensionAllGetter)
+	}
+	if _, ok := d.(SubmissionTracker); ok {
+		extensions = append(extensions, ExtensionSubmissionTracker)
+	}
+	if _, ok := d.(GasEstimator); ok {
+		extensions = append(extensions, ExtensionGasEstimator)
+	}
+	return extensions
+}
@@ -0,0 +1,282 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/rollkit/go-da"
+)
+
+func maxBlobSizeCmd(ctx context.Context, c *cli, args []string) error {
+	if len(args) != 0 {
+		return errors.New("max-blob-size takes no arguments")
+	}
+	maxBlobSize, err := c.da.MaxBlobSize(ctx)
+	if err != nil {
+		return err
+	}
+	return c.print(map[string]uint64{"max_blob_size": maxBlobSize}, func(w io.Writer) {
+		fmt.Fprintln(w, maxBlobSize)
+	})
+}
+
+func submitCmd(ctx context.Context, c *cli, args []string) error {
+	fs := flag.NewFlagSet("submit", flag.ContinueOnError)
+	gasPrice := fs.Float64("gas-price", da.AutoGasPrice, "gas price; negative value means that DA estimates gas price")
+	options := fs.String("options", "", "options passed to SubmitWithOptions (typically JSON)")
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+	blobs, err := c.readBlobs(fs.Args())
+	if err != nil {
+		return err
+	}
+
+	var ids []da.ID
+	if *options != "" {
+		ids, err = c.da.SubmitWithOptions(ctx, blobs, *gasPrice, c.ns, []byte(*options))
+	} else {
+		ids, err = c.da.Submit(ctx, blobs, *gasPrice, c.ns)
+	}
+	if err != nil {
+		return err
+	}
+	return c.printValues(ids)
+}
+
+type blobOutput struct {
+	ID   string `json:"id"`
+	Blob string `json:"blob"`
+	Size int    `json:"size"`
+}
+
+func getCmd(ctx context.Context, c *cli, args []string) error {
+	fs := flag.NewFlagSet("get", flag.ContinueOnError)
+	raw := fs.Bool("raw", false, "write raw contents of blobs to output, ignoring output format")
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+	ids, err := c.readIDs(fs.Args())
+	if err != nil {
+		return err
+	}
+
+	blobs, err := c.da.Get(ctx, ids, c.ns)
+	if err != nil {
+		return err
+	}
+	if *raw {
+		for _, blob := range blobs {
+			if _, err := c.stdout.Write(blob); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+
+	out := make([]blobOutput, len(blobs))
+	for i, blob := range blobs {
+		out[i] = blobOutput{ID: c.codec.encode(ids[i]), Blob: c.codec.encode(blob), Size: len(blob)}
+	}
+	return c.print(out, func(w io.Writer) {
+		for _, b := range out {
+			fmt.Fprintf(w, "%s (%d bytes)\n%s\n", b.ID, b.Size, b.Blob)
+		}
+	})
+}
+
+type idsOutput struct {
+	Height    uint64    `json:"height"`
+	IDs       []string  `json:"ids"`
+	Timestamp time.Time `json:"timestamp"`
+}
+
+func idsCmd(ctx context.Context, c *cli, args []string) error {
+	if len(args) != 1 {
+		return errors.New("ids takes exactly one argument: height")
+	}
+	height, err := strconv.ParseUint(args[0], 10, 64)
+	if err != nil {
+		return fmt.Errorf("invalid height %q: %w", args[0], err)
+	}
+
+	res, err := c.da.GetIDs(ctx, height, c.ns)
+	if err != nil {
+		return err
+	}
+	out := idsOutput{Height: height, IDs: []string{}}
+	if res != nil {
+		out.IDs = c.codec.encodeAll(res.IDs)
+		out.Timestamp = res.Timestamp
+	}
+	return c.print(out, func(w io.Writer) {
+		if res == nil {
+			fmt.Fprintf(w, "height %d: no data\n", height)
+			return
+		}
+		fmt.Fprintf(w, "height %d, timestamp %s, %d IDs\n", height, out.Timestamp.Format(time.RFC3339Nano), len(out.IDs))
+		for _, id := range out.IDs {
+			fmt.Fprintln(w, id)
+		}
+	})
+}
+
+func proofsCmd(ctx context.Context, c *cli, args []string) error {
+	ids, err := c.readIDs(args)
+	if err != nil {
+		return err
+	}
+	proofs, err := c.da.GetProofs(ctx, ids, c.ns)
+	if err != nil {
+		return err
+	}
+	return c.printValues(proofs)
+}
+
+func commitCmd(ctx context.Context, c *cli, args []string) error {
+	blobs, err := c.readBlobs(args)
+	if err != nil {
+		return err
+	}
+	commitments, err := c.da.Commit(ctx, blobs, c.ns)
+	if err != nil {
+		return err
+	}
+	return c.printValues(commitments)
+}
+
+type validationOutput struct {
+	ID    string `json:"id"`
+	Valid bool   `json:"valid"`
+}
+
+func validateCmd(ctx context.Context, c *cli, args []string) error {
+	fs := flag.NewFlagSet("validate", flag.ContinueOnError)
+	rawProofs := fs.String("proofs", "", "comma-separated proofs; fetched from DA if empty")
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+	ids, err := c.readIDs(fs.Args())
+	if err != nil {
+		return err
+	}
+
+	var proofs []da.Proof
+	if *rawProofs != "" {
+		if proofs, err = c.codec.decodeAll(strings.Split(*rawProofs, ",")); err != nil {
+			return err
+		}
+		if len(proofs) != len(ids) {
+			return fmt.Errorf("got %d proofs for %d IDs", len(proofs), len(ids))
+		}
+	} else if proofs, err = c.da.GetProofs(ctx, ids, c.ns); err != nil {
+		return err
+	}
+
+	results, err := c.da.Validate(ctx, ids, proofs, c.ns)
+	if err != nil {
+		return err
+	}
+	out := make([]validationOutput, len(results))
+	for i, valid := range results {
+		out[i] = validationOutput{ID: c.codec.encode(ids[i]), Valid: valid}
+	}
+	return c.print(out, func(w io.Writer) {
+		for _, v := range out {
+			result := "valid"
+			if !v.Valid {
+				result = "invalid"
+			}
+			fmt.Fprintf(w, "%s %s\n", v.ID, result)
+		}
+	})
+}
+
+func latestHeightCmd(ctx context.Context, c *cli, args []string) error {
+	if len(args) != 0 {
+		return errors.New("latest-height takes no arguments")
+	}
+	getter, ok := c.da.(da.LatestHeightGetter)
+	if !ok {
+		return errors.New("DA client doesn't support LatestHeight")
+	}
+	height, err := getter.LatestHeight(ctx)
+	if err != nil {
+		return err
+	}
+	return c.print(map[string]uint64{"height": height}, func(w io.Writer) {
+		fmt.Fprintln(w, height)
+	})
+}
+
+type capabilitiesOutput struct {
+	NamespaceSize uint32   `json:"namespace_size"`
+	OptionsSchema string   `json:"options_schema,omitempty"`
+	ProofType     string   `json:"proof_type"`
+	MaxBlobSize   uint64   `json:"max_blob_size"`
+	BlockTime     string   `json:"block_time"`
+	Extensions    []string `json:"extensions"`
+}
+
+func capabilitiesCmd(ctx context.Context, c *cli, args []string) error {
+	if len(args) != 0 {
+		return errors.New("capabilities takes no arguments")
+	}
+	capabilities, err := da.GetCapabilities(ctx, c.da)
+	if err != nil {
+		return err
+	}
+	out := capabilitiesOutput{
+		NamespaceSize: capabilities.NamespaceSize,
+		OptionsSchema: capabilities.OptionsSchema,
+		ProofType:     capabilities.ProofType,
+		MaxBlobSize:   capabilities.MaxBlobSize,
+		BlockTime:     capabilities.BlockTime.String(),
+		Extensions:    capabilities.Extensions,
+	}
+	return c.print(out, func(w io.Writer) {
+		fmt.Fprintf(w, "namespace size: %d\n", capabilities.NamespaceSize)
+		fmt.Fprintf(w, "max blob size:  %d\n", capabilities.MaxBlobSize)
+		fmt.Fprintf(w, "block time:     %s\n", capabilities.BlockTime)
+		fmt.Fprintf(w, "proof type:     %s\n", capabilities.ProofType)
+		fmt.Fprintf(w, "extensions:     %s\n", strings.Join(capabilities.Extensions, ", "))
+		if capabilities.OptionsSchema != "" {
+			fmt.Fprintf(w, "options schema: %s\n", capabilities.OptionsSchema)
+		}
+	})
+}
+
+// readBlobs reads contents of given files as blobs. Stdin is read if no files are given, or for "-".
+func (c *cli) readBlobs(files []string) ([]da.Blob, error) {
+	if len(files) == 0 {
+		files = []string{"-"}
+	}
+	blobs := make([]da.Blob, len(files))
+	for i, file := range files {
+		var err error
+		if file == "-" {
+			blobs[i], err = io.ReadAll(c.stdin)
+		} else {
+			blobs[i], err = os.ReadFile(file) //nolint:gosec
+		}
+		if err != nil {
+			return nil, err
+		}
+	}
+	return blobs, nil
+}
+
+// readIDs decodes IDs given as arguments.
+func (c *cli) readIDs(args []string) ([]da.ID, error) {
+	if len(args) == 0 {
+		return nil, errors.New("at least one ID is required")
+	}
+	return c.codec.decodeAll(args)
+}
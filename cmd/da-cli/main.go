@@ -0,0 +1,134 @@
+// Command da-cli calls methods of DA served over gRPC or JSON-RPC, for debugging DA deployments.
+//
+// Usage:
+//
+//	da-cli [flags] <command> [command flags] [args]
+//
+// Run "da-cli -h" for the list of flags and commands.
+package main
+
+import (
+	"context"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"sort"
+	"time"
+
+	"github.com/rollkit/go-da"
+	"github.com/rollkit/go-da/namespace"
+	"github.com/rollkit/go-da/proxy"
+	proxygrpc "github.com/rollkit/go-da/proxy/grpc"
+)
+
+func main() {
+	if err := run(context.Background(), os.Args[1:], os.Stdin, os.Stdout); err != nil {
+		if !errors.Is(err, flag.ErrHelp) {
+			fmt.Fprintln(os.Stderr, "error:", err)
+		}
+		os.Exit(1)
+	}
+}
+
+// cli holds the state shared by all commands.
+type cli struct {
+	da     da.DA
+	ns     da.Namespace
+	codec  codec
+	json   bool
+	stdin  io.Reader
+	stdout io.Writer
+}
+
+// command is a single da-cli command.
+type command struct {
+	usage       string
+	description string
+	run         func(ctx context.Context, c *cli, args []string) error
+}
+
+var commands = map[string]command{
+	"max-blob-size": {"", "print the max blob size", maxBlobSizeCmd},
+	"submit":        {"[-gas-price price] [-options options] [file...]", "submit files (or stdin) as blobs", submitCmd},
+	"get":           {"[-raw] id...", "get blobs by IDs", getCmd},
+	"ids":           {"height", "list IDs of blobs included at height", idsCmd},
+	"proofs":        {"id...", "get inclusion proofs of blobs", proofsCmd},
+	"commit":        {"[file...]", "compute commitments of files (or stdin)", commitCmd},
+	"validate":      {"[-proofs proof,...] id...", "validate inclusion proofs (fetched from DA by default)", validateCmd},
+	"latest-height": {"", "print the latest height (if supported by DA)", latestHeightCmd},
+	"capabilities":  {"", "print features supported by DA", capabilitiesCmd},
+}
+
+func run(ctx context.Context, args []string, stdin io.Reader, stdout io.Writer) error {
+	fs := flag.NewFlagSet("da-cli", flag.ContinueOnError)
+	addr := fs.String("addr", "grpc://127.0.0.1:7980", "DA address; supported schemes: grpc, grpcs, http, https, ws, wss")
+	token := fs.String("token", os.Getenv("DA_AUTH_TOKEN"), "auth token (defaults to $DA_AUTH_TOKEN)")
+	tlsCA := fs.String("tls-ca", "", "file with CA certificates used to verify grpcs server (defaults to system roots)")
+	tlsCert := fs.String("tls-cert", "", "file with client certificate presented to grpcs server")
+	tlsKey := fs.String("tls-key", "", "file with private key of client certificate")
+	ns := fs.String("namespace", "", "namespace in hex")
+	encoding := fs.String("encoding", "hex", "encoding of IDs, proofs, commitments and blobs: hex or base64")
+	output := fs.String("output", "human", "output format: human or json")
+	timeout := fs.Duration("timeout", 30*time.Second, "timeout of the command")
+	fs.Usage = func() {
+		out := fs.Output()
+		fmt.Fprintf(out, "Usage: da-cli [flags] <command> [command flags] [args]\n\nFlags:\n")
+		fs.PrintDefaults()
+		fmt.Fprintf(out, "\nCommands:\n")
+		names := make([]string, 0, len(commands))
+		for name := range commands {
+			names = append(names, name)
+		}
+		sort.Strings(names)
+		for _, name := range names {
+			cmd := commands[name]
+			fmt.Fprintf(out, "  %s %s\n    \t%s\n", name, cmd.usage, cmd.description)
+		}
+	}
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+	if fs.NArg() == 0 {
+		fs.Usage()
+		return errors.New("command is required")
+	}
+	cmd, ok := commands[fs.Arg(0)]
+	if !ok {
+		return fmt.Errorf("unknown command %q", fs.Arg(0))
+	}
+
+	c := &cli{stdin: stdin, stdout: stdout}
+	switch *output {
+	case "human":
+	case "json":
+		c.json = true
+	default:
+		return fmt.Errorf("unknown output format %q", *output)
+	}
+	var err error
+	if c.codec, err = newCodec(*encoding); err != nil {
+		return err
+	}
+	if *ns != "" {
+		if c.ns, err = namespace.ParseHex(*ns); err != nil {
+			return err
+		}
+	}
+
+	tlsConfig, err := proxygrpc.NewClientTLSConfig(*tlsCA, *tlsCert, *tlsKey)
+	if err != nil {
+		return err
+	}
+	client, err := proxy.NewClient(*addr, *token, proxy.WithTLSConfig(tlsConfig))
+	if err != nil {
+		return err
+	}
+	defer client.Close() //nolint:errcheck
+	c.da = client
+
+	ctx, cancel := context.WithTimeout(ctx, *timeout)
+	defer cancel()
+	return cmd.run(ctx, c, fs.Args()[1:])
+}
@@ -0,0 +1,95 @@
+package main
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"net"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/rollkit/go-da"
+	"github.com/rollkit/go-da/proxy/auth"
+	proxygrpc "github.com/rollkit/go-da/proxy/grpc"
+	"github.com/rollkit/go-da/test"
+)
+
+func startServer(t *testing.T) string {
+	lis, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	srv := proxygrpc.NewServer(test.NewDummyDA(), proxygrpc.WithUnauthenticatedAccess(auth.AllPerms...))
+	go func() {
+		_ = srv.Serve(lis)
+	}()
+	t.Cleanup(srv.Stop)
+	return "grpc://" + lis.Addr().String()
+}
+
+func runCLI(t *testing.T, stdin string, args ...string) string {
+	var stdout bytes.Buffer
+	err := run(context.TODO(), args, strings.NewReader(stdin), &stdout)
+	require.NoError(t, err)
+	return stdout.String()
+}
+
+func TestCLI(t *testing.T) {
+	addr := startServer(t)
+
+	out := runCLI(t, "", "-addr", addr, "max-blob-size")
+	assert.Equal(t, "1974272\n", out)
+
+	var ids []string
+	out = runCLI(t, "hello", "-addr", addr, "-namespace", "0x0102", "-output", "json", "submit")
+	require.NoError(t, json.Unmarshal([]byte(out), &ids))
+	require.Len(t, ids, 1)
+
+	var blobs []blobOutput
+	out = runCLI(t, "", "-addr", addr, "-namespace", "0102", "-output", "json", "get", ids[0])
+	require.NoError(t, json.Unmarshal([]byte(out), &blobs))
+	assert.Equal(t, []blobOutput{{ID: ids[0], Blob: "68656c6c6f", Size: 5}}, blobs)
+
+	out = runCLI(t, "", "-addr", addr, "-namespace", "0102", "get", "-raw", ids[0])
+	assert.Equal(t, "hello", out)
+
+	var listed idsOutput
+	out = runCLI(t, "", "-addr", addr, "-namespace", "0102", "-output", "json", "ids", "1")
+	require.NoError(t, json.Unmarshal([]byte(out), &listed))
+	assert.Equal(t, ids, listed.IDs)
+
+	out = runCLI(t, "", "-addr", addr, "-namespace", "0102", "validate", ids[0])
+	assert.Equal(t, ids[0]+" valid\n", out)
+
+	out = runCLI(t, "", "-addr", addr, "-namespace", "0102", "proofs", ids[0])
+	proof := strings.TrimSpace(out)
+	out = runCLI(t, "", "-addr", addr, "validate", "-proofs", proof, ids[0])
+	assert.Equal(t, ids[0]+" invalid\n", out)
+
+	out = runCLI(t, "", "-addr", addr, "latest-height")
+	assert.Equal(t, "1\n", out)
+
+	var capabilities map[string]interface{}
+	out = runCLI(t, "", "-addr", addr, "-output", "json", "capabilities")
+	require.NoError(t, json.Unmarshal([]byte(out), &capabilities))
+	assert.Equal(t, "ed25519", capabilities["proof_type"])
+	assert.Equal(t, "0s", capabilities["block_time"])
+	assert.EqualValues(t, 1974272, capabilities["max_blob_size"])
+}
+
+func TestCLIErrors(t *testing.T) {
+	addr := startServer(t)
+
+	err := run(context.TODO(), []string{"-addr", addr}, nil, &bytes.Buffer{})
+	assert.Error(t, err)
+
+	err = run(context.TODO(), []string{"-addr", addr, "unknown"}, nil, &bytes.Buffer{})
+	assert.ErrorContains(t, err, "unknown command")
+
+	err = run(context.TODO(), []string{"-addr", addr, "get", "not-hex"}, nil, &bytes.Buffer{})
+	assert.ErrorContains(t, err, "invalid value")
+
+	err = run(context.TODO(), []string{"-addr", addr, "ids", "2"}, nil, &bytes.Buffer{})
+	assert.ErrorIs(t, err, &da.ErrFutureHeight{})
+}
@@ -0,0 +1,66 @@
+package main
+
+import (
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+)
+
+// codec encodes binary values (IDs, proofs, commitments and blobs) in command arguments and output.
+type codec struct {
+	encode func([]byte) string
+	decode func(string) ([]byte, error)
+}
+
+func newCodec(encoding string) (codec, error) {
+	switch encoding {
+	case "hex":
+		return codec{encode: hex.EncodeToString, decode: hex.DecodeString}, nil
+	case "base64":
+		return codec{encode: base64.StdEncoding.EncodeToString, decode: base64.StdEncoding.DecodeString}, nil
+	default:
+		return codec{}, fmt.Errorf("unknown encoding %q", encoding)
+	}
+}
+
+func (c codec) encodeAll(values [][]byte) []string {
+	encoded := make([]string, len(values))
+	for i, v := range values {
+		encoded[i] = c.encode(v)
+	}
+	return encoded
+}
+
+func (c codec) decodeAll(values []string) ([][]byte, error) {
+	decoded := make([][]byte, len(values))
+	for i, v := range values {
+		var err error
+		if decoded[i], err = c.decode(v); err != nil {
+			return nil, fmt.Errorf("invalid value %q: %w", v, err)
+		}
+	}
+	return decoded, nil
+}
+
+// print writes v as indented JSON or in human format, depending on the output format.
+func (c *cli) print(v interface{}, human func(w io.Writer)) error {
+	if c.json {
+		enc := json.NewEncoder(c.stdout)
+		enc.SetIndent("", "  ")
+		return enc.Encode(v)
+	}
+	human(c.stdout)
+	return nil
+}
+
+// printValues prints encoded binary values, one per line in human format.
+func (c *cli) printValues(values [][]byte) error {
+	encoded := c.codec.encodeAll(values)
+	return c.print(encoded, func(w io.Writer) {
+		for _, v := range encoded {
+			fmt.Fprintln(w, v)
+		}
+	})
+}
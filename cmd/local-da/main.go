@@ -0,0 +1,237 @@
+// Command local-da serves DummyDA over gRPC and/or JSON-RPC, for use in local devnets.
+//
+// Usage:
+//
+//	local-da [flags]
+//
+// Flags:
+//
+//	-grpc-address string
+//		listen address of gRPC server, empty to disable (default "127.0.0.1:7980")
+//	-jsonrpc-address string
+//		listen address of JSON-RPC server, empty to disable (default "127.0.0.1:7981")
+//	-jwt-secret-file string
+//		file containing HMAC secret used to verify JWT tokens of gRPC and JSON-RPC requests, empty to allow
+//		unauthenticated access
+//	-tls-cert string
+//		file with certificate of gRPC server, empty to serve gRPC without TLS
+//	-tls-key string
+//		file with private key of gRPC server certificate
+//	-tls-client-ca string
+//		file with CA certificates used to verify client certificates, empty to not require client certificates
+//	-metrics-address string
+//		listen address of Prometheus /metrics endpoint, empty to disable
+//	-block-time duration
+//		interval of producing new heights, zero to create new height on every submission
+//	-mempool-ttl uint
+//		number of blocks after which submissions not included in a block are dropped (default 5)
+//	-max-blob-size uint
+//		max size of single blob in bytes (default 1974272)
+//	-max-block-size uint
+//		max total size of blobs included at single height in bytes (default 7897088)
+//	-dir string
+//		directory used to persist DA data, empty to keep data in memory only
+//	-shutdown-timeout duration
+//		time allowed for graceful shutdown (default 10s)
+package main
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"flag"
+	"fmt"
+	"log"
+	"net"
+	"net/http"
+	"os"
+	"os/signal"
+	"syscall"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/collectors"
+	grpcgo "google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
+
+	"github.com/rollkit/go-da"
+	"github.com/rollkit/go-da/metrics"
+	"github.com/rollkit/go-da/proxy/auth"
+	"github.com/rollkit/go-da/proxy/grpc"
+	"github.com/rollkit/go-da/proxy/jsonrpc"
+	"github.com/rollkit/go-da/test"
+)
+
+type config struct {
+	grpcAddress     string
+	jsonrpcAddress  string
+	jwtSecretFile   string
+	tlsCert         string
+	tlsKey          string
+	tlsClientCA     string
+	metricsAddress  string
+	blockTime       time.Duration
+	mempoolTTL      uint64
+	maxBlobSize     uint64
+	maxBlockSize    uint64
+	dir             string
+	shutdownTimeout time.Duration
+}
+
+func main() {
+	var cfg config
+	flag.StringVar(&cfg.grpcAddress, "grpc-address", "127.0.0.1:7980", "listen address of gRPC server, empty to disable")
+	flag.StringVar(&cfg.jsonrpcAddress, "jsonrpc-address", "127.0.0.1:7981", "listen address of JSON-RPC server, empty to disable")
+	flag.StringVar(&cfg.jwtSecretFile, "jwt-secret-file", "", "file containing HMAC secret used to verify JWT tokens of gRPC and JSON-RPC requests, empty to allow unauthenticated access")
+	flag.StringVar(&cfg.tlsCert, "tls-cert", "", "file with certificate of gRPC server, empty to serve gRPC without TLS")
+	flag.StringVar(&cfg.tlsKey, "tls-key", "", "file with private key of gRPC server certificate")
+	flag.StringVar(&cfg.tlsClientCA, "tls-client-ca", "", "file with CA certificates used to verify client certificates, empty to not require client certificates")
+	flag.StringVar(&cfg.metricsAddress, "metrics-address", "", "listen address of Prometheus /metrics endpoint, empty to disable")
+	flag.DurationVar(&cfg.blockTime, "block-time", 0, "interval of producing new heights, zero to create new height on every submission")
+	flag.Uint64Var(&cfg.mempoolTTL, "mempool-ttl", test.DefaultMempoolTTL, "number of blocks after which submissions not included in a block are dropped")
+	flag.Uint64Var(&cfg.maxBlobSize, "max-blob-size", test.DefaultMaxBlobSize, "max size of single blob in bytes")
+	flag.Uint64Var(&cfg.maxBlockSize, "max-block-size", test.DefaultMaxBlockSize, "max total size of blobs included at single height in bytes")
+	flag.StringVar(&cfg.dir, "dir", "", "directory used to persist DA data, empty to keep data in memory only")
+	flag.DurationVar(&cfg.shutdownTimeout, "shutdown-timeout", 10*time.Second, "time allowed for graceful shutdown")
+	flag.Parse()
+
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	defer stop()
+
+	if err := run(ctx, cfg); err != nil {
+		log.Fatal(err)
+	}
+}
+
+// run serves DummyDA until ctx is done, then shuts servers down gracefully.
+func run(ctx context.Context, cfg config) (err error) {
+	if cfg.grpcAddress == "" && cfg.jsonrpcAddress == "" {
+		return errors.New("at least one of gRPC and JSON-RPC servers must be enabled")
+	}
+
+	opts := []func(*test.DummyDA) *test.DummyDA{
+		test.WithBlockTime(cfg.blockTime),
+		test.WithMempoolTTL(cfg.mempoolTTL),
+		test.WithMaxBlobSize(cfg.maxBlobSize),
+		test.WithMaxBlockSize(cfg.maxBlockSize),
+	}
+	var d *test.DummyDA
+	if cfg.dir != "" {
+		d, err = test.NewPersistentDummyDA(cfg.dir, opts...)
+		if err != nil {
+			return fmt.Errorf("failed to open DA in %s: %w", cfg.dir, err)
+		}
+		log.Printf("persisting data in %s", cfg.dir)
+	} else {
+		d = test.NewDummyDA(opts...)
+	}
+	defer func() {
+		err = errors.Join(err, d.Close())
+	}()
+
+	var served da.DA = d
+	if cfg.metricsAddress != "" {
+		collector := metrics.NewCollector("da_server")
+		registry := prometheus.NewRegistry()
+		registry.MustRegister(collector, collectors.NewGoCollector())
+		served = metrics.Wrap(d, collector)
+
+		mux := http.NewServeMux()
+		mux.Handle("/metrics", metrics.Handler(registry))
+		srv := &http.Server{Addr: cfg.metricsAddress, Handler: mux, ReadHeaderTimeout: 2 * time.Second}
+		var lis net.Listener
+		lis, err = net.Listen("tcp", cfg.metricsAddress)
+		if err != nil {
+			return fmt.Errorf("failed to listen on %s: %w", cfg.metricsAddress, err)
+		}
+		go func() {
+			if err := srv.Serve(lis); err != nil && !errors.Is(err, http.ErrServerClosed) {
+				log.Printf("metrics server failed: %s", err)
+			}
+		}()
+		log.Printf("serving metrics on %s/metrics", lis.Addr())
+
+		defer func() {
+			stopCtx, cancel := context.WithTimeout(context.Background(), cfg.shutdownTimeout)
+			defer cancel()
+			err = errors.Join(err, srv.Shutdown(stopCtx))
+		}()
+	}
+
+	var verifier auth.Verifier
+	if cfg.jwtSecretFile != "" {
+		secret, err := os.ReadFile(cfg.jwtSecretFile)
+		if err != nil {
+			return fmt.Errorf("failed to read JWT secret: %w", err)
+		}
+		verifier = auth.NewJWTVerifier(bytes.TrimSpace(secret))
+	}
+
+	if cfg.grpcAddress != "" {
+		opts := []grpcgo.ServerOption{grpc.WithUnauthenticatedAccess(auth.AllPerms...)}
+		if verifier != nil {
+			opts = []grpcgo.ServerOption{grpc.WithVerifier(verifier)}
+		}
+		if cfg.tlsCert != "" {
+			tlsConfig, err := grpc.NewServerTLSConfig(cfg.tlsCert, cfg.tlsKey, cfg.tlsClientCA)
+			if err != nil {
+				return err
+			}
+			opts = append(opts, grpcgo.Creds(credentials.NewTLS(tlsConfig)))
+		}
+		lis, err := net.Listen("tcp", cfg.grpcAddress)
+		if err != nil {
+			return fmt.Errorf("failed to listen on %s: %w", cfg.grpcAddress, err)
+		}
+		srv := grpc.NewServer(served, opts...)
+		go func() {
+			if err := srv.Serve(lis); err != nil {
+				log.Printf("gRPC server failed: %s", err)
+			}
+		}()
+		log.Printf("serving gRPC on %s", lis.Addr())
+
+		defer func() {
+			done := make(chan struct{})
+			go func() {
+				srv.GracefulStop()
+				close(done)
+			}()
+			select {
+			case <-done:
+			case <-time.After(cfg.shutdownTimeout):
+				srv.Stop()
+			}
+			log.Print("gRPC server stopped")
+		}()
+	}
+
+	if cfg.jsonrpcAddress != "" {
+		// named return err must not be shadowed, as deferred shutdown joins its error
+		var host, port string
+		host, port, err = net.SplitHostPort(cfg.jsonrpcAddress)
+		if err != nil {
+			return fmt.Errorf("invalid JSON-RPC address %s: %w", cfg.jsonrpcAddress, err)
+		}
+		authOpt := jsonrpc.WithUnauthenticatedAccess(auth.AllPerms...)
+		if verifier != nil {
+			authOpt = jsonrpc.WithVerifier(verifier)
+		}
+		srv := jsonrpc.NewServer(host, port, served, authOpt)
+		if err := srv.Start(ctx); err != nil {
+			return fmt.Errorf("failed to start JSON-RPC server: %w", err)
+		}
+		log.Printf("serving JSON-RPC on %s", srv.Addr())
+
+		defer func() {
+			stopCtx, cancel := context.WithTimeout(context.Background(), cfg.shutdownTimeout)
+			defer cancel()
+			err = errors.Join(err, srv.Stop(stopCtx))
+			log.Print("JSON-RPC server stopped")
+		}()
+	}
+
+	<-ctx.Done()
+	log.Print("shutting down")
+	return nil
+}
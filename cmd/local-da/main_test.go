@@ -0,0 +1,89 @@
+package main
+
+import (
+	"context"
+	"net"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+
+	proxygrpc "github.com/rollkit/go-da/proxy/grpc"
+	proxyjsonrpc "github.com/rollkit/go-da/proxy/jsonrpc"
+	"github.com/rollkit/go-da/test"
+)
+
+func testConfig() config {
+	return config{
+		grpcAddress:     "127.0.0.1:0",
+		jsonrpcAddress:  "127.0.0.1:0",
+		metricsAddress:  "127.0.0.1:0",
+		mempoolTTL:      test.DefaultMempoolTTL,
+		maxBlobSize:     test.DefaultMaxBlobSize,
+		maxBlockSize:    test.DefaultMaxBlockSize,
+		shutdownTimeout: time.Second,
+	}
+}
+
+// freeAddr returns a local address that was free when checked, so test can dial servers started by run.
+func freeAddr(t *testing.T) string {
+	lis, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	addr := lis.Addr().String()
+	require.NoError(t, lis.Close())
+	return addr
+}
+
+func TestRun(t *testing.T) {
+	cfg := testConfig()
+	cfg.grpcAddress, cfg.jsonrpcAddress = freeAddr(t), freeAddr(t)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	done := make(chan error)
+	go func() {
+		done <- run(ctx, cfg)
+	}()
+
+	grpcClient := proxygrpc.NewClient()
+	require.NoError(t, grpcClient.Start(cfg.grpcAddress, grpc.WithTransportCredentials(insecure.NewCredentials())))
+	defer func() {
+		_ = grpcClient.Stop()
+	}()
+	// servers are started asynchronously
+	require.Eventually(t, func() bool {
+		maxBlobSize, err := grpcClient.MaxBlobSize(ctx)
+		return err == nil && maxBlobSize == cfg.maxBlobSize
+	}, 5*time.Second, 10*time.Millisecond)
+
+	jsonrpcClient, err := proxyjsonrpc.NewClient(ctx, "http://"+cfg.jsonrpcAddress, "")
+	require.NoError(t, err)
+	defer jsonrpcClient.Close()
+	require.Eventually(t, func() bool {
+		maxBlobSize, err := jsonrpcClient.DA.MaxBlobSize(ctx)
+		return err == nil && maxBlobSize == cfg.maxBlobSize
+	}, 5*time.Second, 10*time.Millisecond)
+
+	cancel()
+	select {
+	case err := <-done:
+		assert.NoError(t, err)
+	case <-time.After(5 * time.Second):
+		t.Fatal("run didn't return after ctx was canceled")
+	}
+}
+
+func TestRunErrors(t *testing.T) {
+	cfg := testConfig()
+	cfg.grpcAddress, cfg.jsonrpcAddress = "", ""
+	assert.Error(t, run(context.Background(), cfg))
+
+	cfg = testConfig()
+	cfg.jsonrpcAddress = "no-port"
+	err := run(context.Background(), cfg)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "invalid JSON-RPC address")
+}
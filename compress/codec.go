@@ -0,0 +1,134 @@
+package compress
+
+import (
+	"bytes"
+	"compress/gzip"
+	"errors"
+	"fmt"
+	"io"
+
+	"github.com/klauspost/compress/zstd"
+)
+
+// Compressed blobs start with a header:
+//
+//	magic (2 bytes) | version (1 byte) | codec (1 byte) | data
+//
+// Blobs without the header are legacy, uncompressed blobs, and are returned as is. Legacy blob starting with bytes
+// of a valid header would be misinterpreted, but such blobs are unlikely in practice.
+
+// Codec identifies compression algorithm of a blob.
+type Codec uint8
+
+const (
+	// CodecNone stores data uncompressed. It's used if compression doesn't reduce the size of blob.
+	CodecNone Codec = 0
+	// CodecZstd compresses data with Zstandard.
+	CodecZstd Codec = 1
+	// CodecGzip compresses data with gzip.
+	CodecGzip Codec = 2
+)
+
+const (
+	headerVersion = 1
+	// HeaderSize is the size of header prepended to every blob submitted by Compressor.
+	HeaderSize = 4
+)
+
+var magic = [2]byte{0xda, 0xc0}
+
+func (c Codec) String() string {
+	switch c {
+	case CodecNone:
+		return "none"
+	case CodecZstd:
+		return "zstd"
+	case CodecGzip:
+		return "gzip"
+	default:
+		return fmt.Sprintf("unknown(%d)", uint8(c))
+	}
+}
+
+// ParseCodec returns Codec with given name: "none", "zstd" or "gzip".
+func ParseCodec(name string) (Codec, error) {
+	for _, codec := range []Codec{CodecNone, CodecZstd, CodecGzip} {
+		if codec.String() == name {
+			return codec, nil
+		}
+	}
+	return 0, fmt.Errorf("unknown codec %q", name)
+}
+
+// codecs holds encoders and decoders, safe for concurrent use.
+type codecs struct {
+	zstdEncoder    *zstd.Encoder
+	zstdDecoder    *zstd.Decoder
+	maxDecodedSize uint64
+}
+
+func newCodecs(maxDecodedSize uint64) *codecs {
+	// errors are returned only for invalid options
+	encoder, _ := zstd.NewWriter(nil, zstd.WithEncoderConcurrency(1))
+	decoder, _ := zstd.NewReader(nil, zstd.WithDecoderConcurrency(0), zstd.WithDecoderMaxMemory(maxDecodedSize))
+	return &codecs{zstdEncoder: encoder, zstdDecoder: decoder, maxDecodedSize: maxDecodedSize}
+}
+
+// encode compresses blob with given codec and prepends the header. If compressed data isn't smaller than the blob,
+// it's stored uncompressed.
+func (c *codecs) encode(codec Codec, blob []byte) ([]byte, error) {
+	header := []byte{magic[0], magic[1], headerVersion, byte(codec)}
+	var encoded []byte
+	switch codec {
+	case CodecNone:
+		encoded = append(header, blob...)
+	case CodecZstd:
+		encoded = c.zstdEncoder.EncodeAll(blob, header)
+	case CodecGzip:
+		buf := bytes.NewBuffer(header)
+		w := gzip.NewWriter(buf)
+		if _, err := w.Write(blob); err != nil {
+			return nil, err
+		}
+		if err := w.Close(); err != nil {
+			return nil, err
+		}
+		encoded = buf.Bytes()
+	default:
+		return nil, fmt.Errorf("unknown codec %s", codec)
+	}
+	if codec != CodecNone && len(encoded) >= HeaderSize+len(blob) {
+		return c.encode(CodecNone, blob)
+	}
+	return encoded, nil
+}
+
+// decode returns the original blob, decompressing data of blobs with header.
+func (c *codecs) decode(data []byte) ([]byte, error) {
+	if len(data) < HeaderSize || data[0] != magic[0] || data[1] != magic[1] || data[2] != headerVersion {
+		return data, nil
+	}
+	payload := data[HeaderSize:]
+	switch Codec(data[3]) {
+	case CodecNone:
+		return payload, nil
+	case CodecZstd:
+		return c.zstdDecoder.DecodeAll(payload, nil)
+	case CodecGzip:
+		r, err := gzip.NewReader(bytes.NewReader(payload))
+		if err != nil {
+			return nil, err
+		}
+		blob, err := io.ReadAll(io.LimitReader(r, int64(c.maxDecodedSize)+1)) //nolint:gosec
+		if err != nil {
+			return nil, err
+		}
+		if uint64(len(blob)) > c.maxDecodedSize {
+			return nil, errors.New("decompressed blob is too large")
+		}
+		return blob, nil
+	default:
+		// not a header written by Compressor
+		return data, nil
+	}
+}
@@ -0,0 +1,162 @@
+// Package compress provides a da.DA wrapper transparently compressing blobs.
+package compress
+
+import (
+	"context"
+
+	"github.com/rollkit/go-da"
+)
+
+// DefaultMaxDecodedSize is the default max size of decompressed blob, protecting Get from decompression bombs.
+const DefaultMaxDecodedSize = 64 << 20
+
+// Compressor is a da.DA wrapper compressing blobs on Submit and Commit, and decompressing them on Get.
+//
+// Every blob is prefixed with a header identifying the codec. Blobs without the header, submitted without
+// Compressor, are returned unchanged by Get. Blobs that don't get smaller are stored uncompressed (with CodecNone).
+//
+// By default, MaxBlobSize reports the max blob size of wrapped DA reduced by HeaderSize, so every accepted blob fits
+// even if it doesn't compress. WithMaxBlobSize allows larger blobs, expected to compress well; blobs not fitting in
+// wrapped DA after compression are rejected with da.ErrBlobSizeOverLimit.
+//
+// Compressor implements da.DA only: GetAll of wrapped DA would return blobs with codec headers, and SubmitWithReceipt
+// would store them uncompressed. da.GetAll on Compressor decompresses blobs through Get.
+type Compressor struct {
+	target         da.DA
+	codec          Codec
+	maxBlobSize    uint64
+	maxDecodedSize uint64
+	codecs         *codecs
+}
+
+var _ da.DA = &Compressor{}
+
+// New creates new Compressor wrapping target DA. Zstd is used, unless configured otherwise with WithCodec.
+func New(target da.DA, opts ...func(*Compressor) *Compressor) *Compressor {
+	c := &Compressor{target: target, codec: CodecZstd, maxDecodedSize: DefaultMaxDecodedSize}
+	for _, opt := range opts {
+		c = opt(c)
+	}
+	c.codecs = newCodecs(max(c.maxDecodedSize, c.maxBlobSize))
+	return c
+}
+
+// WithCodec configures the codec used to compress submitted blobs. Blobs compressed with any codec can be read.
+func WithCodec(codec Codec) func(*Compressor) *Compressor {
+	return func(c *Compressor) *Compressor {
+		c.codec = codec
+		return c
+	}
+}
+
+// WithMaxBlobSize configures the max size of uncompressed blob accepted by Compressor, reported by MaxBlobSize.
+func WithMaxBlobSize(maxBlobSize uint64) func(*Compressor) *Compressor {
+	return func(c *Compressor) *Compressor {
+		c.maxBlobSize = maxBlobSize
+		return c
+	}
+}
+
+// WithMaxDecodedSize configures the max size of blob decompressed by Get. It's never less than max blob size.
+func WithMaxDecodedSize(maxDecodedSize uint64) func(*Compressor) *Compressor {
+	return func(c *Compressor) *Compressor {
+		c.maxDecodedSize = maxDecodedSize
+		return c
+	}
+}
+
+// MaxBlobSize returns the max size of uncompressed blob accepted by Compressor.
+func (c *Compressor) MaxBlobSize(ctx context.Context) (uint64, error) {
+	if c.maxBlobSize != 0 {
+		return c.maxBlobSize, nil
+	}
+	targetMaxBlobSize, err := c.target.MaxBlobSize(ctx)
+	if err != nil {
+		return 0, err
+	}
+	if targetMaxBlobSize < HeaderSize {
+		return 0, nil
+	}
+	return targetMaxBlobSize - HeaderSize, nil
+}
+
+// Get returns decompressed Blobs for given IDs.
+func (c *Compressor) Get(ctx context.Context, ids []da.ID, ns da.Namespace) ([]da.Blob, error) {
+	blobs, err := c.target.Get(ctx, ids, ns)
+	if err != nil {
+		return nil, err
+	}
+	for i := range blobs {
+		if blobs[i], err = c.codecs.decode(blobs[i]); err != nil {
+			return nil, err
+		}
+	}
+	return blobs, nil
+}
+
+// GetIDs returns IDs of all Blobs located in DA at given height.
+func (c *Compressor) GetIDs(ctx context.Context, height uint64, ns da.Namespace) (*da.GetIDsResult, error) {
+	return c.target.GetIDs(ctx, height, ns)
+}
+
+// GetProofs returns inclusion Proofs for Blobs specified by their IDs.
+func (c *Compressor) GetProofs(ctx context.Context, ids []da.ID, ns da.Namespace) ([]da.Proof, error) {
+	return c.target.GetProofs(ctx, ids, ns)
+}
+
+// Commit returns commitments of compressed blobs, matching commitments of blobs submitted by Compressor.
+func (c *Compressor) Commit(ctx context.Context, blobs []da.Blob, ns da.Namespace) ([]da.Commitment, error) {
+	compressed, err := c.compress(ctx, blobs)
+	if err != nil {
+		return nil, err
+	}
+	return c.target.Commit(ctx, compressed, ns)
+}
+
+// Submit compresses blobs and submits them to wrapped DA.
+func (c *Compressor) Submit(ctx context.Context, blobs []da.Blob, gasPrice float64, ns da.Namespace) ([]da.ID, error) {
+	compressed, err := c.compress(ctx, blobs)
+	if err != nil {
+		return nil, err
+	}
+	return c.target.Submit(ctx, compressed, gasPrice, ns)
+}
+
+// SubmitWithOptions compresses blobs and submits them to wrapped DA.
+func (c *Compressor) SubmitWithOptions(ctx context.Context, blobs []da.Blob, gasPrice float64, ns da.Namespace, options []byte) ([]da.ID, error) {
+	compressed, err := c.compress(ctx, blobs)
+	if err != nil {
+		return nil, err
+	}
+	return c.target.SubmitWithOptions(ctx, compressed, gasPrice, ns, options)
+}
+
+// Validate validates Proofs for given IDs.
+func (c *Compressor) Validate(ctx context.Context, ids []da.ID, proofs []da.Proof, ns da.Namespace) ([]bool, error) {
+	return c.target.Validate(ctx, ids, proofs, ns)
+}
+
+// compress compresses every blob, checking both the size of blob and the size of compressed data.
+func (c *Compressor) compress(ctx context.Context, blobs []da.Blob) ([]da.Blob, error) {
+	targetMaxBlobSize, err := c.target.MaxBlobSize(ctx)
+	if err != nil {
+		return nil, err
+	}
+	maxBlobSize := c.maxBlobSize
+	if maxBlobSize == 0 && targetMaxBlobSize >= HeaderSize {
+		maxBlobSize = targetMaxBlobSize - HeaderSize
+	}
+	compressed := make([]da.Blob, len(blobs))
+	for i, blob := range blobs {
+		if uint64(len(blob)) > maxBlobSize {
+			return nil, &da.ErrBlobSizeOverLimit{}
+		}
+		if compressed[i], err = c.codecs.encode(c.codec, blob); err != nil {
+			return nil, err
+		}
+		if uint64(len(compressed[i])) > targetMaxBlobSize {
+			return nil, &da.ErrBlobSizeOverLimit{}
+		}
+	}
+	return compressed, nil
+}
@@ -0,0 +1,140 @@
+package compress_test
+
+import (
+	"bytes"
+	"context"
+	"crypto/rand"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/rollkit/go-da"
+	"github.com/rollkit/go-da/compress"
+	"github.com/rollkit/go-da/test"
+)
+
+func TestCompressor(t *testing.T) {
+	for _, codec := range []compress.Codec{compress.CodecNone, compress.CodecZstd, compress.CodecGzip} {
+		t.Run(codec.String(), func(t *testing.T) {
+			test.RunDATestSuite(t, compress.New(test.NewDummyDA(), compress.WithCodec(codec)))
+		})
+	}
+}
+
+func TestCompressorRoundTrip(t *testing.T) {
+	ctx := context.TODO()
+	ns := da.Namespace("ns")
+	random := make([]byte, 1000)
+	_, err := rand.Read(random)
+	require.NoError(t, err)
+	blobs := []da.Blob{{}, bytes.Repeat([]byte("block data "), 500), random}
+
+	for _, codec := range []compress.Codec{compress.CodecNone, compress.CodecZstd, compress.CodecGzip} {
+		t.Run(codec.String(), func(t *testing.T) {
+			dummy := test.NewDummyDA()
+			c := compress.New(dummy, compress.WithCodec(codec))
+			ids, err := c.Submit(ctx, blobs, 0, ns)
+			require.NoError(t, err)
+
+			ret, err := c.Get(ctx, ids, ns)
+			require.NoError(t, err)
+			assert.Equal(t, blobs, ret)
+
+			// compressible blob is smaller in wrapped DA, others grow only by the header
+			stored, err := dummy.Get(ctx, ids, ns)
+			require.NoError(t, err)
+			assert.Len(t, stored[0], compress.HeaderSize)
+			if codec == compress.CodecNone {
+				assert.Len(t, stored[1], compress.HeaderSize+len(blobs[1]))
+			} else {
+				assert.Less(t, len(stored[1]), len(blobs[1])/10)
+			}
+			assert.Len(t, stored[2], compress.HeaderSize+len(random))
+
+			commitments, err := c.Commit(ctx, blobs, ns)
+			require.NoError(t, err)
+			expected, err := dummy.Commit(ctx, stored, ns)
+			require.NoError(t, err)
+			assert.Equal(t, expected, commitments)
+		})
+	}
+}
+
+func TestCompressorLegacyBlobs(t *testing.T) {
+	ctx := context.TODO()
+	ns := da.Namespace("ns")
+	dummy := test.NewDummyDA()
+	blobs := []da.Blob{{}, []byte("raw"), {0xda, 0xc0, 0xff, 0x01, 0x02}, {0xda, 0xc0, 0x01, 0xff}}
+	ids, err := dummy.Submit(ctx, blobs, 0, ns)
+	require.NoError(t, err)
+
+	// every codec reads blobs submitted by others
+	zstdIDs, err := compress.New(dummy).Submit(ctx, []da.Blob{[]byte("zstd zstd zstd zstd zstd zstd")}, 0, ns)
+	require.NoError(t, err)
+	ids = append(ids, zstdIDs...)
+	blobs = append(blobs, []byte("zstd zstd zstd zstd zstd zstd"))
+
+	ret, err := compress.New(dummy, compress.WithCodec(compress.CodecGzip)).Get(ctx, ids, ns)
+	require.NoError(t, err)
+	assert.Equal(t, blobs, ret)
+}
+
+func TestCompressorMaxBlobSize(t *testing.T) {
+	ctx := context.TODO()
+	ns := da.Namespace("ns")
+	dummy := test.NewDummyDA(test.WithMaxBlobSize(1024))
+
+	c := compress.New(dummy)
+	maxBlobSize, err := c.MaxBlobSize(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, uint64(1024-compress.HeaderSize), maxBlobSize)
+	test.BlobSizeOverLimitTest(t, c)
+
+	// larger blobs are accepted, as long as they fit after compression
+	c = compress.New(dummy, compress.WithMaxBlobSize(4096))
+	maxBlobSize, err = c.MaxBlobSize(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, uint64(4096), maxBlobSize)
+
+	ids, err := c.Submit(ctx, []da.Blob{bytes.Repeat([]byte{1}, 4096)}, 0, ns)
+	require.NoError(t, err)
+	ret, err := c.Get(ctx, ids, ns)
+	require.NoError(t, err)
+	assert.Equal(t, []da.Blob{bytes.Repeat([]byte{1}, 4096)}, ret)
+
+	random := make([]byte, 2048)
+	_, err = rand.Read(random)
+	require.NoError(t, err)
+	_, err = c.Submit(ctx, []da.Blob{random}, 0, ns)
+	assert.ErrorIs(t, err, &da.ErrBlobSizeOverLimit{})
+	_, err = c.Submit(ctx, []da.Blob{make([]byte, 4097)}, 0, ns)
+	assert.ErrorIs(t, err, &da.ErrBlobSizeOverLimit{})
+}
+
+func TestCompressorMaxDecodedSize(t *testing.T) {
+	ctx := context.TODO()
+	ns := da.Namespace("ns")
+	dummy := test.NewDummyDA()
+	blob := make([]byte, 10000)
+
+	for _, codec := range []compress.Codec{compress.CodecZstd, compress.CodecGzip} {
+		ids, err := compress.New(dummy, compress.WithCodec(codec)).Submit(ctx, []da.Blob{blob}, 0, ns)
+		require.NoError(t, err)
+		_, err = compress.New(dummy, compress.WithMaxDecodedSize(1000)).Get(ctx, ids, ns)
+		assert.Error(t, err, codec.String())
+		ret, err := compress.New(dummy, compress.WithMaxDecodedSize(10000)).Get(ctx, ids, ns)
+		require.NoError(t, err)
+		assert.Equal(t, []da.Blob{blob}, ret)
+	}
+}
+
+func TestParseCodec(t *testing.T) {
+	for _, codec := range []compress.Codec{compress.CodecNone, compress.CodecZstd, compress.CodecGzip} {
+		parsed, err := compress.ParseCodec(codec.String())
+		require.NoError(t, err)
+		assert.Equal(t, codec, parsed)
+	}
+	_, err := compress.ParseCodec("lz4")
+	assert.Error(t, err)
+}
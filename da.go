@@ -29,18 +29,166 @@ type DA interface {
 	//
 	// This method is synchronous. Upon successful submission to Data Availability layer, it returns the IDs identifying blobs
 	// in DA.
+	//
+	// Negative gasPrice (see AutoGasPrice) means that gas price estimated by DA should be used.
 	Submit(ctx context.Context, blobs []Blob, gasPrice float64, namespace Namespace) ([]ID, error)
 
 	// SubmitWithOptions submits the Blobs to Data Availability layer.
 	//
 	// This method is synchronous. Upon successful submission to Data Availability layer, it returns the IDs identifying blobs
 	// in DA.
+	//
+	// Negative gasPrice (see AutoGasPrice) means that gas price estimated by DA should be used.
 	SubmitWithOptions(ctx context.Context, blobs []Blob, gasPrice float64, namespace Namespace, options []byte) ([]ID, error)
 
 	// Validate validates Commitments against the corresponding Proofs. This should be possible without retrieving the Blobs.
 	Validate(ctx context.Context, ids []ID, proofs []Proof, namespace Namespace) ([]bool, error)
 }
 
+// Subscriber is an optional interface implemented by DA layers able to notify about new heights.
+type Subscriber interface {
+	// Subscribe returns a channel delivering every new height, together with IDs of Blobs located in DA at that height.
+	//
+	// Only heights produced after the subscription was established are delivered. The channel is closed when ctx is
+	// done or the subscription can't be continued.
+	Subscribe(ctx context.Context, namespace Namespace) (<-chan *SubscriptionEvent, error)
+}
+
+// Subscribe forwards the call to d if it implements Subscriber, or returns ErrNotSupported.
+func Subscribe(ctx context.Context, d DA, namespace Namespace) (<-chan *SubscriptionEvent, error) {
+	if subscriber, ok := d.(Subscriber); ok {
+		return subscriber.Subscribe(ctx, namespace)
+	}
+	return nil, &ErrNotSupported{}
+}
+
+// LatestHeightGetter is an optional interface implemented by DA layers able to report their current tip.
+type LatestHeightGetter interface {
+	// LatestHeight returns the height of the latest block available in DA.
+	//
+	// GetIDs called with any height greater than returned one should fail with ErrFutureHeight.
+	LatestHeight(ctx context.Context) (uint64, error)
+}
+
+// LatestHeight forwards the call to d if it implements LatestHeightGetter, or returns ErrNotSupported.
+func LatestHeight(ctx context.Context, d DA) (uint64, error) {
+	if getter, ok := d.(LatestHeightGetter); ok {
+		return getter.LatestHeight(ctx)
+	}
+	return 0, &ErrNotSupported{}
+}
+
+// AllGetter is an optional interface implemented by DA layers able to return all Blobs at given height in a single
+// call. Use GetAll function to support DA layers not implementing it.
+type AllGetter interface {
+	// GetAll returns IDs and Blobs of all Blobs located in DA at given height.
+	GetAll(ctx context.Context, height uint64, namespace Namespace) (*GetAllResult, error)
+}
+
+// GetAll returns IDs and Blobs of all Blobs located in DA at given height.
+//
+// If d implements AllGetter, the call is forwarded to it. Otherwise, the result is composed of GetIDs and Get calls.
+func GetAll(ctx context.Context, d DA, height uint64, namespace Namespace) (*GetAllResult, error) {
+	if getter, ok := d.(AllGetter); ok {
+		return getter.GetAll(ctx, height, namespace)
+	}
+
+	ret, err := d.GetIDs(ctx, height, namespace)
+	if err != nil {
+		return nil, err
+	}
+	if ret == nil {
+		return nil, nil
+	}
+
+	result := &GetAllResult{IDs: ret.IDs, Blobs: []Blob{}, Timestamp: ret.Timestamp}
+	if len(ret.IDs) > 0 {
+		result.Blobs, err = d.Get(ctx, ret.IDs, namespace)
+		if err != nil {
+			return nil, err
+		}
+	}
+	return result, nil
+}
+
+// SubmissionTracker is an optional interface implemented by DA layers able to track the status of submissions.
+type SubmissionTracker interface {
+	// SubmitWithReceipt submits the Blobs to Data Availability layer.
+	//
+	// This method is asynchronous. It returns as soon as submission is accepted by DA, with a Receipt that can be used
+	// to track the submission with GetSubmissionStatus.
+	SubmitWithReceipt(ctx context.Context, blobs []Blob, gasPrice float64, namespace Namespace, options []byte) (Receipt, error)
+
+	// GetSubmissionStatus returns the status of submission identified by given Receipt.
+	//
+	// ErrReceiptNotFound should be returned if Receipt is unknown to DA.
+	GetSubmissionStatus(ctx context.Context, receipt Receipt) (*SubmissionStatus, error)
+}
+
+// SubmitWithReceipt forwards the call to d if it implements SubmissionTracker, or returns ErrNotSupported.
+func SubmitWithReceipt(ctx context.Context, d DA, blobs []Blob, gasPrice float64, namespace Namespace, options []byte) (Receipt, error) {
+	if tracker, ok := d.(SubmissionTracker); ok {
+		return tracker.SubmitWithReceipt(ctx, blobs, gasPrice, namespace, options)
+	}
+	return nil, &ErrNotSupported{}
+}
+
+// GetSubmissionStatus forwards the call to d if it implements SubmissionTracker, or returns ErrNotSupported.
+func GetSubmissionStatus(ctx context.Context, d DA, receipt Receipt) (*SubmissionStatus, error) {
+	if tracker, ok := d.(SubmissionTracker); ok {
+		return tracker.GetSubmissionStatus(ctx, receipt)
+	}
+	return nil, &ErrNotSupported{}
+}
+
+// AutoGasPrice can be passed as gasPrice to submit methods, to use the gas price estimated by DA.
+const AutoGasPrice float64 = -1
+
+// GasEstimator is an optional interface implemented by DA layers able to estimate the gas price.
+type GasEstimator interface {
+	// GasPrice returns the gas price estimated by DA.
+	GasPrice(ctx context.Context) (float64, error)
+
+	// GasMultiplier returns the multiplier that should be applied to gas price on resubmission.
+	GasMultiplier(ctx context.Context) (float64, error)
+}
+
+// GasPrice forwards the call to d if it implements GasEstimator, or returns ErrNotSupported.
+func GasPrice(ctx context.Context, d DA) (float64, error) {
+	if estimator, ok := d.(GasEstimator); ok {
+		return estimator.GasPrice(ctx)
+	}
+	return 0, &ErrNotSupported{}
+}
+
+// GasMultiplier forwards the call to d if it implements GasEstimator, or returns ErrNotSupported.
+func GasMultiplier(ctx context.Context, d DA) (float64, error) {
+	if estimator, ok := d.(GasEstimator); ok {
+		return estimator.GasMultiplier(ctx)
+	}
+	return 0, &ErrNotSupported{}
+}
+
+// ResolveGasPrice returns gasPrice if it's not negative. Otherwise, gas price estimated by d is returned, or 0 if d
+// doesn't implement GasEstimator.
+func ResolveGasPrice(ctx context.Context, d DA, gasPrice float64) (float64, error) {
+	if gasPrice >= 0 {
+		return gasPrice, nil
+	}
+	if estimator, ok := d.(GasEstimator); ok {
+		return estimator.GasPrice(ctx)
+	}
+	return 0, nil
+}
+
+// NamespaceValidator is an optional interface implemented by DA layers expecting namespaces of specific layout.
+//
+// Proxy servers use it to reject requests with malformed namespaces with ErrInvalidNamespace.
+type NamespaceValidator interface {
+	// ValidateNamespace returns an error if namespace is malformed.
+	ValidateNamespace(namespace Namespace) error
+}
+
 // Namespace is an optional parameter used to set the location a blob should be
 // posted to, for DA layers supporting the functionality.
 type Namespace = []byte
@@ -51,6 +199,9 @@ type Blob = []byte
 // ID should contain serialized data required by the implementation to find blob in Data Availability layer.
 type ID = []byte
 
+// Receipt should contain serialized data required by the implementation to track the status of submission.
+type Receipt = []byte
+
 // Commitment should contain serialized cryptographic commitment to Blob value.
 type Commitment = []byte
 
@@ -62,3 +213,38 @@ type GetIDsResult struct {
 	IDs       []ID
 	Timestamp time.Time
 }
+
+// GetAllResult holds the result of GetAll call: IDs, Blobs and timestamp of corresponding block.
+type GetAllResult struct {
+	IDs       []ID
+	Blobs     []Blob
+	Timestamp time.Time
+}
+
+// SubmissionState is the state of submission identified by Receipt.
+type SubmissionState uint8
+
+// Submission states reported by SubmissionTracker. Values are the same as in protobuf definitions.
+const (
+	SubmissionStateUnspecified SubmissionState = iota
+	SubmissionStatePending
+	SubmissionStateIncluded
+	SubmissionStateFailed
+)
+
+// SubmissionStatus holds the result of GetSubmissionStatus call.
+//
+// Height and IDs are set only for included submissions, Error only for failed ones.
+type SubmissionStatus struct {
+	State  SubmissionState
+	Height uint64
+	IDs    []ID
+	Error  string
+}
+
+// SubscriptionEvent holds a single new height delivered by Subscriber, together with the result of GetIDs call at
+// that height.
+type SubscriptionEvent struct {
+	Height uint64
+	GetIDsResult
+}
@@ -1,8 +1,21 @@
 package da_test
 
 import (
+	"context"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sync"
 	"testing"
+	"time"
 
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/rollkit/go-da"
+	"github.com/rollkit/go-da/mocks"
 	"github.com/rollkit/go-da/test"
 )
 
@@ -10,3 +23,375 @@ func TestDummyDA(t *testing.T) {
 	dummy := test.NewDummyDA()
 	test.RunDATestSuite(t, dummy)
 }
+
+func TestDummyDASizeLimits(t *testing.T) {
+	dummy := test.NewDummyDA(test.WithMaxBlobSize(1024), test.WithMaxBlockSize(4096))
+	test.BlobSizeOverLimitTest(t, dummy)
+	test.TxTooLargeTest(t, dummy, 4096)
+
+	_, err := dummy.SubmitWithReceipt(context.TODO(), []da.Blob{make([]byte, 1025)}, 0, nil, nil)
+	assert.ErrorIs(t, err, &da.ErrBlobSizeOverLimit{})
+}
+
+func TestPersistentDummyDA(t *testing.T) {
+	ctx := context.TODO()
+	dir := t.TempDir()
+	ns := da.Namespace("persistent")
+
+	dummy, err := test.NewPersistentDummyDA(dir)
+	require.NoError(t, err)
+	test.RunDATestSuite(t, dummy)
+
+	blobs := []da.Blob{[]byte("first"), []byte("second")}
+	ids, err := dummy.Submit(ctx, blobs, 0, ns)
+	require.NoError(t, err)
+	height, err := dummy.LatestHeight(ctx)
+	require.NoError(t, err)
+	before, err := dummy.GetIDs(ctx, height, ns)
+	require.NoError(t, err)
+	require.NoError(t, dummy.Close())
+
+	reopened, err := test.NewPersistentDummyDA(dir)
+	require.NoError(t, err)
+	defer func() { require.NoError(t, reopened.Close()) }()
+
+	latest, err := reopened.LatestHeight(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, height, latest)
+
+	after, err := reopened.GetIDs(ctx, height, ns)
+	require.NoError(t, err)
+	assert.Equal(t, before.IDs, after.IDs)
+	assert.True(t, before.Timestamp.Equal(after.Timestamp))
+
+	got, err := reopened.Get(ctx, ids, ns)
+	require.NoError(t, err)
+	assert.Equal(t, blobs, got)
+
+	proofs, err := dummy.GetProofs(ctx, ids, ns)
+	require.NoError(t, err)
+	valid, err := reopened.Validate(ctx, ids, proofs, ns)
+	require.NoError(t, err)
+	assert.Equal(t, []bool{true, true}, valid)
+
+	_, err = reopened.Submit(ctx, blobs, 0, ns)
+	require.NoError(t, err)
+	latest, err = reopened.LatestHeight(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, height+1, latest)
+}
+
+func TestPersistentDummyDATornWrite(t *testing.T) {
+	ctx := context.TODO()
+	dir := t.TempDir()
+
+	dummy, err := test.NewPersistentDummyDA(dir)
+	require.NoError(t, err)
+	_, err = dummy.Submit(ctx, []da.Blob{[]byte("blob")}, 0, nil)
+	require.NoError(t, err)
+	require.NoError(t, dummy.Close())
+
+	f, err := os.OpenFile(filepath.Join(dir, "blocks.log"), os.O_WRONLY|os.O_APPEND, 0)
+	require.NoError(t, err)
+	_, err = f.WriteString(`{"Height":2,"Timest`)
+	require.NoError(t, err)
+	require.NoError(t, f.Close())
+
+	reopened, err := test.NewPersistentDummyDA(dir)
+	require.NoError(t, err)
+	defer func() { require.NoError(t, reopened.Close()) }()
+
+	latest, err := reopened.LatestHeight(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, uint64(1), latest)
+
+	_, err = reopened.Submit(ctx, []da.Blob{[]byte("blob")}, 0, nil)
+	require.NoError(t, err)
+	latest, err = reopened.LatestHeight(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, uint64(2), latest)
+}
+
+func TestGetAllFallback(t *testing.T) {
+	ctx := context.TODO()
+	ns := da.Namespace("ns")
+	ids := []da.ID{[]byte("id1"), []byte("id2")}
+	blobs := []da.Blob{[]byte("blob1"), []byte("blob2")}
+	timestamp := time.Now()
+
+	mockDA := mocks.NewMockDA(t)
+	mockDA.EXPECT().GetIDs(ctx, uint64(1), ns).Return(&da.GetIDsResult{IDs: ids, Timestamp: timestamp}, nil)
+	mockDA.EXPECT().Get(ctx, ids, ns).Return(blobs, nil)
+	mockDA.EXPECT().GetIDs(ctx, uint64(2), ns).Return(nil, &da.ErrFutureHeight{})
+
+	ret, err := da.GetAll(ctx, mockDA, 1, ns)
+	require.NoError(t, err)
+	assert.Equal(t, &da.GetAllResult{IDs: ids, Blobs: blobs, Timestamp: timestamp}, ret)
+
+	ret, err = da.GetAll(ctx, mockDA, 2, ns)
+	assert.ErrorIs(t, err, &da.ErrFutureHeight{})
+	assert.Nil(t, ret)
+}
+
+// forwarder exposes every optional interface of DummyDA, but declares target as the wrapped DA.
+type forwarder struct {
+	*test.DummyDA
+	target da.DA
+}
+
+func (f *forwarder) Unwrap() da.DA {
+	return f.target
+}
+
+func TestNotSupported(t *testing.T) {
+	ctx := context.TODO()
+	hidden := struct{ da.DA }{test.NewDummyDA()}
+
+	_, err := da.Subscribe(ctx, hidden, nil)
+	assert.ErrorIs(t, err, &da.ErrNotSupported{})
+	_, err = da.LatestHeight(ctx, hidden)
+	assert.ErrorIs(t, err, &da.ErrNotSupported{})
+	_, err = da.SubmitWithReceipt(ctx, hidden, []da.Blob{[]byte("blob")}, 0, nil, nil)
+	assert.ErrorIs(t, err, &da.ErrNotSupported{})
+	_, err = da.GetSubmissionStatus(ctx, hidden, []byte("receipt"))
+	assert.ErrorIs(t, err, &da.ErrNotSupported{})
+	_, err = da.GasPrice(ctx, hidden)
+	assert.ErrorIs(t, err, &da.ErrNotSupported{})
+	_, err = da.GasMultiplier(ctx, hidden)
+	assert.ErrorIs(t, err, &da.ErrNotSupported{})
+
+	_, err = da.LatestHeight(ctx, test.NewDummyDA())
+	assert.NoError(t, err)
+
+	// extensions of wrappers are the extensions of wrapped DA
+	assert.Empty(t, da.SupportedExtensions(&forwarder{DummyDA: test.NewDummyDA(), target: hidden}))
+	assert.Equal(t, da.SupportedExtensions(test.NewDummyDA()),
+		da.SupportedExtensions(&forwarder{DummyDA: test.NewDummyDA(), target: test.NewDummyDA()}))
+}
+
+func TestDummyDAInclusionDelay(t *testing.T) {
+	dummy := test.NewDummyDA(test.WithInclusionDelay(100 * time.Millisecond))
+	test.RunDATestSuite(t, dummy)
+
+	ctx := context.TODO()
+	receipt, err := dummy.SubmitWithReceipt(ctx, []da.Blob{[]byte("delayed")}, 0, nil, nil)
+	require.NoError(t, err)
+
+	status, err := dummy.GetSubmissionStatus(ctx, receipt)
+	require.NoError(t, err)
+	assert.Equal(t, da.SubmissionStatePending, status.State)
+	assert.Empty(t, status.IDs)
+
+	require.Eventually(t, func() bool {
+		status, err = dummy.GetSubmissionStatus(ctx, receipt)
+		return err == nil && status.State == da.SubmissionStateIncluded
+	}, time.Second, 10*time.Millisecond)
+	assert.Len(t, status.IDs, 1)
+}
+
+func TestDummyDABlockTime(t *testing.T) {
+	dummy := test.NewDummyDA(test.WithBlockTime(10 * time.Millisecond))
+	defer func() { require.NoError(t, dummy.Close()) }()
+	test.RunDATestSuite(t, dummy)
+
+	ctx := context.TODO()
+	capabilities, err := dummy.Capabilities(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, 10*time.Millisecond, capabilities.BlockTime)
+
+	// heights are produced on timer, including concurrent submissions
+	start, err := dummy.LatestHeight(ctx)
+	require.NoError(t, err)
+	var wg sync.WaitGroup
+	ids := make([][]da.ID, 2)
+	for i := range ids {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			var err error
+			ids[i], err = dummy.Submit(ctx, []da.Blob{[]byte{byte(i)}}, 0, nil)
+			assert.NoError(t, err)
+		}(i)
+	}
+	wg.Wait()
+	require.Eventually(t, func() bool {
+		height, err := dummy.LatestHeight(ctx)
+		return err == nil && height > start+5
+	}, time.Second, 10*time.Millisecond)
+	for i := range ids {
+		require.Len(t, ids[i], 1)
+		ret, err := dummy.GetIDs(ctx, binary.LittleEndian.Uint64(ids[i][0]), nil)
+		require.NoError(t, err)
+		assert.Contains(t, ret.IDs, ids[i][0])
+	}
+}
+
+func TestDummyDABlockTimeErrors(t *testing.T) {
+	ctx := context.TODO()
+
+	t.Run("context deadline", func(t *testing.T) {
+		dummy := test.NewDummyDA(test.WithBlockTime(time.Hour))
+		defer func() { require.NoError(t, dummy.Close()) }()
+
+		ctx, cancel := context.WithTimeout(ctx, 10*time.Millisecond)
+		defer cancel()
+		ids, err := dummy.Submit(ctx, []da.Blob{[]byte("blob")}, 0, nil)
+		assert.Nil(t, ids)
+		assert.ErrorIs(t, err, &da.ErrContextDeadline{})
+	})
+
+	t.Run("mempool TTL", func(t *testing.T) {
+		dummy := test.NewDummyDA(
+			test.WithBlockTime(50*time.Millisecond),
+			test.WithMaxBlockSize(10),
+			test.WithMempoolTTL(1),
+		)
+		defer func() { require.NoError(t, dummy.Close()) }()
+
+		included, err := dummy.SubmitWithReceipt(ctx, []da.Blob{make([]byte, 10)}, 0, nil, nil)
+		require.NoError(t, err)
+		dropped, err := dummy.SubmitWithReceipt(ctx, []da.Blob{make([]byte, 10)}, 0, nil, nil)
+		require.NoError(t, err)
+
+		require.Eventually(t, func() bool {
+			status, err := dummy.GetSubmissionStatus(ctx, dropped)
+			return err == nil && status.State == da.SubmissionStateFailed
+		}, time.Second, 10*time.Millisecond)
+		status, err := dummy.GetSubmissionStatus(ctx, dropped)
+		require.NoError(t, err)
+		assert.Equal(t, (&da.ErrTxTimedOut{}).Error(), status.Error)
+
+		status, err = dummy.GetSubmissionStatus(ctx, included)
+		require.NoError(t, err)
+		assert.Equal(t, da.SubmissionStateIncluded, status.State)
+	})
+
+	t.Run("closed", func(t *testing.T) {
+		dummy := test.NewDummyDA(test.WithBlockTime(time.Hour))
+
+		errs := make(chan error)
+		go func() {
+			_, err := dummy.Submit(ctx, []da.Blob{[]byte("blob")}, 0, nil)
+			errs <- err
+		}()
+		require.NoError(t, dummy.Close())
+		assert.Error(t, <-errs)
+
+		_, err := dummy.Submit(ctx, []da.Blob{[]byte("blob")}, 0, nil)
+		assert.Error(t, err)
+	})
+}
+
+func TestResolveGasPrice(t *testing.T) {
+	ctx := context.TODO()
+	dummy := test.NewDummyDA(test.WithGasPrice(1.5))
+	mockDA := mocks.NewMockDA(t)
+
+	gasPrice, err := da.ResolveGasPrice(ctx, dummy, 0.5)
+	require.NoError(t, err)
+	assert.Equal(t, 0.5, gasPrice)
+
+	gasPrice, err = da.ResolveGasPrice(ctx, dummy, da.AutoGasPrice)
+	require.NoError(t, err)
+	assert.Equal(t, 1.5, gasPrice)
+
+	gasPrice, err = da.ResolveGasPrice(ctx, mockDA, da.AutoGasPrice)
+	require.NoError(t, err)
+	assert.Zero(t, gasPrice)
+}
+
+func TestFaultyDA(t *testing.T) {
+	ctx := context.TODO()
+	blobs := []da.Blob{[]byte("first"), []byte("second")}
+
+	t.Run("no faults", func(t *testing.T) {
+		test.BasicDATest(t, test.NewFaultyDA(test.NewDummyDA(), 1))
+	})
+
+	t.Run("reproducible errors", func(t *testing.T) {
+		submitErrors := func() []error {
+			faulty := test.NewFaultyDA(test.NewDummyDA(), 42, test.WithFault(test.Fault{ErrorRate: 0.5}, "Submit"))
+			errs := make([]error, 20)
+			for i := range errs {
+				_, errs[i] = faulty.Submit(ctx, blobs, 0, nil)
+			}
+			return errs
+		}
+		errs := submitErrors()
+		assert.Equal(t, errs, submitErrors())
+		assert.Contains(t, errs, nil)
+		for _, err := range errs {
+			if err != nil {
+				assert.Contains(t, test.DefaultFaultErrors, err)
+			}
+		}
+	})
+
+	t.Run("custom errors", func(t *testing.T) {
+		faulty := test.NewFaultyDA(test.NewDummyDA(), 1,
+			test.WithFault(test.Fault{ErrorRate: 1, Errors: []error{&da.ErrBlobNotFound{}}}))
+		_, err := faulty.MaxBlobSize(ctx)
+		assert.ErrorIs(t, err, &da.ErrBlobNotFound{})
+		_, err = faulty.GetIDs(ctx, 1, nil)
+		assert.ErrorIs(t, err, &da.ErrBlobNotFound{})
+	})
+
+	t.Run("latency", func(t *testing.T) {
+		faulty := test.NewFaultyDA(test.NewDummyDA(), 1, test.WithFault(test.Fault{Latency: time.Hour}, "Submit"))
+		ctx, cancel := context.WithTimeout(ctx, 10*time.Millisecond)
+		defer cancel()
+		_, err := faulty.Submit(ctx, blobs, 0, nil)
+		assert.ErrorIs(t, err, &da.ErrContextDeadline{})
+	})
+
+	t.Run("dropped submissions", func(t *testing.T) {
+		faulty := test.NewFaultyDA(test.NewDummyDA(), 1, test.WithFault(test.Fault{DropRate: 1}, "Submit"))
+		ids, err := faulty.Submit(ctx, blobs, 0, nil)
+		require.NoError(t, err)
+		assert.Len(t, ids, len(blobs))
+		_, err = faulty.Get(ctx, ids, nil)
+		assert.ErrorIs(t, err, &da.ErrBlobNotFound{})
+	})
+
+	t.Run("corrupted blobs and invalid proofs", func(t *testing.T) {
+		faulty := test.NewFaultyDA(test.NewDummyDA(), 1,
+			test.WithFault(test.Fault{CorruptRate: 1, InvalidProofRate: 1}, "Get", "GetProofs"))
+		ids, err := faulty.Submit(ctx, blobs, 0, nil)
+		require.NoError(t, err)
+
+		got, err := faulty.Get(ctx, ids, nil)
+		require.NoError(t, err)
+		require.Len(t, got, len(blobs))
+		for i := range blobs {
+			assert.NotEqual(t, blobs[i], got[i])
+		}
+
+		proofs, err := faulty.GetProofs(ctx, ids, nil)
+		require.NoError(t, err)
+		valid, err := faulty.Validate(ctx, ids, proofs, nil)
+		require.NoError(t, err)
+		assert.Equal(t, []bool{false, false}, valid)
+	})
+}
+
+func TestIsRetryable(t *testing.T) {
+	assert.True(t, da.IsRetryable(&da.ErrTxTimedOut{}))
+	assert.True(t, da.IsRetryable(&da.ErrTxAlreadyInMempool{}))
+	assert.True(t, da.IsRetryable(&da.ErrTxIncorrectAccountSequence{}))
+	assert.True(t, da.IsRetryable(fmt.Errorf("wrapped: %w", &da.ErrContextDeadline{})))
+
+	assert.False(t, da.IsRetryable(nil))
+	assert.False(t, da.IsRetryable(errors.New("unknown")))
+	assert.False(t, da.IsRetryable(&da.ErrBlobNotFound{}))
+	assert.False(t, da.IsRetryable(&da.ErrTxTooLarge{}))
+	assert.False(t, da.IsRetryable(&da.ErrFutureHeight{}))
+}
+
+func TestCodeOf(t *testing.T) {
+	assert.Equal(t, da.CodeTxTimedOut, da.CodeOf(&da.ErrTxTimedOut{}))
+	assert.Equal(t, da.CodeBlobNotFound, da.CodeOf(fmt.Errorf("wrapped: %w", &da.ErrBlobNotFound{})))
+	assert.Equal(t, da.CodeInvalidNamespace, da.CodeOf(&da.ErrInvalidNamespace{}))
+	assert.Equal(t, da.CodeNotSupported, da.CodeOf(fmt.Errorf("wrapped: %w", &da.ErrNotSupported{})))
+	assert.Equal(t, da.Code(0), da.CodeOf(nil))
+	assert.Equal(t, da.Code(0), da.CodeOf(errors.New("unknown")))
+}
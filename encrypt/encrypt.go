@@ -0,0 +1,133 @@
+// Package encrypt provides a da.DA wrapper encrypting blobs, keeping them confidential to key holders.
+package encrypt
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/rollkit/go-da"
+)
+
+// Encryptor is a da.DA wrapper encrypting blobs on Submit and Commit, and decrypting them on Get.
+//
+// Every blob is sealed in an envelope holding ID of the key used for encryption, so keys can be rotated: new blobs
+// are encrypted with the current key of KeyProvider, while blobs encrypted with older keys remain readable as long as
+// KeyProvider holds them. Get fails with error wrapping ErrUnknownKey for blobs encrypted with other keys, and with
+// error for blobs not encrypted at all.
+//
+// MaxBlobSize reports the max blob size of wrapped DA reduced by the size of envelope.
+//
+// Encryptor implements da.DA only, so blobs can't leave it in plaintext: SubmitWithReceipt of wrapped DA would publish
+// them unencrypted. da.GetAll on Encryptor decrypts blobs through Get, rather than returning sealed envelopes.
+type Encryptor struct {
+	target da.DA
+	keys   KeyProvider
+}
+
+var _ da.DA = &Encryptor{}
+
+// New creates new Encryptor wrapping target DA, using keys provided by given KeyProvider.
+func New(target da.DA, keys KeyProvider) *Encryptor {
+	return &Encryptor{target: target, keys: keys}
+}
+
+// MaxBlobSize returns the max size of blob, fitting in wrapped DA after encryption with the current key.
+func (e *Encryptor) MaxBlobSize(ctx context.Context) (uint64, error) {
+	targetMaxBlobSize, err := e.target.MaxBlobSize(ctx)
+	if err != nil {
+		return 0, err
+	}
+	keyID, _, err := e.keys.CurrentKey(ctx)
+	if err != nil {
+		return 0, err
+	}
+	if targetMaxBlobSize < overhead(keyID) {
+		return 0, nil
+	}
+	return targetMaxBlobSize - overhead(keyID), nil
+}
+
+// Get returns decrypted Blobs for given IDs.
+func (e *Encryptor) Get(ctx context.Context, ids []da.ID, ns da.Namespace) ([]da.Blob, error) {
+	envelopes, err := e.target.Get(ctx, ids, ns)
+	if err != nil {
+		return nil, err
+	}
+	blobs := make([]da.Blob, len(envelopes))
+	for i, envelope := range envelopes {
+		keyID, err := parseEnvelope(envelope)
+		if err != nil {
+			return nil, fmt.Errorf("blob %d: %w", i, err)
+		}
+		key, err := e.keys.Key(ctx, keyID)
+		if errors.Is(err, ErrUnknownKey) {
+			return nil, fmt.Errorf("blob %d: %w %q", i, ErrUnknownKey, keyID)
+		}
+		if err != nil {
+			return nil, err
+		}
+		if blobs[i], err = open(key, envelope, ns); err != nil {
+			return nil, fmt.Errorf("blob %d: failed to decrypt with key %q: %w", i, keyID, err)
+		}
+	}
+	return blobs, nil
+}
+
+// GetIDs returns IDs of all Blobs located in DA at given height.
+func (e *Encryptor) GetIDs(ctx context.Context, height uint64, ns da.Namespace) (*da.GetIDsResult, error) {
+	return e.target.GetIDs(ctx, height, ns)
+}
+
+// GetProofs returns inclusion Proofs for Blobs specified by their IDs.
+func (e *Encryptor) GetProofs(ctx context.Context, ids []da.ID, ns da.Namespace) ([]da.Proof, error) {
+	return e.target.GetProofs(ctx, ids, ns)
+}
+
+// Commit returns commitments of encrypted blobs, matching commitments of blobs submitted by Encryptor with the same
+// current key.
+func (e *Encryptor) Commit(ctx context.Context, blobs []da.Blob, ns da.Namespace) ([]da.Commitment, error) {
+	envelopes, err := e.encrypt(ctx, blobs, ns)
+	if err != nil {
+		return nil, err
+	}
+	return e.target.Commit(ctx, envelopes, ns)
+}
+
+// Submit encrypts blobs and submits them to wrapped DA.
+func (e *Encryptor) Submit(ctx context.Context, blobs []da.Blob, gasPrice float64, ns da.Namespace) ([]da.ID, error) {
+	envelopes, err := e.encrypt(ctx, blobs, ns)
+	if err != nil {
+		return nil, err
+	}
+	return e.target.Submit(ctx, envelopes, gasPrice, ns)
+}
+
+// SubmitWithOptions encrypts blobs and submits them to wrapped DA.
+func (e *Encryptor) SubmitWithOptions(ctx context.Context, blobs []da.Blob, gasPrice float64, ns da.Namespace, options []byte) ([]da.ID, error) {
+	envelopes, err := e.encrypt(ctx, blobs, ns)
+	if err != nil {
+		return nil, err
+	}
+	return e.target.SubmitWithOptions(ctx, envelopes, gasPrice, ns, options)
+}
+
+// Validate validates Proofs for given IDs.
+func (e *Encryptor) Validate(ctx context.Context, ids []da.ID, proofs []da.Proof, ns da.Namespace) ([]bool, error) {
+	return e.target.Validate(ctx, ids, proofs, ns)
+}
+
+// encrypt seals every blob in an envelope, using the current key.
+func (e *Encryptor) encrypt(ctx context.Context, blobs []da.Blob, ns da.Namespace) ([]da.Blob, error) {
+	keyID, key, err := e.keys.CurrentKey(ctx)
+	if err != nil {
+		return nil, err
+	}
+	envelopes := make([]da.Blob, len(blobs))
+	for i, blob := range blobs {
+		if envelopes[i], err = seal(keyID, key, blob, ns); err != nil {
+			return nil, err
+		}
+	}
+	return envelopes, nil
+}
@@ -0,0 +1,143 @@
+package encrypt_test
+
+import (
+	"bytes"
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/rollkit/go-da"
+	"github.com/rollkit/go-da/encrypt"
+	"github.com/rollkit/go-da/test"
+)
+
+func newKeyring(t *testing.T, id string) *encrypt.Keyring {
+	key, err := encrypt.GenerateKey()
+	require.NoError(t, err)
+	keys, err := encrypt.NewKeyring(id, key)
+	require.NoError(t, err)
+	return keys
+}
+
+func TestEncryptor(t *testing.T) {
+	test.RunDATestSuite(t, encrypt.New(test.NewDummyDA(), newKeyring(t, "key-1")))
+}
+
+func TestEncryptorRoundTrip(t *testing.T) {
+	ctx := context.TODO()
+	ns := da.Namespace("ns")
+	dummy := test.NewDummyDA()
+	e := encrypt.New(dummy, newKeyring(t, "key-1"))
+	blobs := []da.Blob{{}, []byte("confidential"), bytes.Repeat([]byte{1}, 1000)}
+
+	ids, err := e.Submit(ctx, blobs, 0, ns)
+	require.NoError(t, err)
+	ret, err := e.Get(ctx, ids, ns)
+	require.NoError(t, err)
+	assert.Equal(t, blobs, ret)
+
+	// wrapped DA holds only ciphertext
+	stored, err := dummy.Get(ctx, ids, ns)
+	require.NoError(t, err)
+	assert.NotContains(t, string(stored[1]), "confidential")
+	for i := range blobs {
+		assert.Greater(t, len(stored[i]), len(blobs[i]))
+	}
+
+	// encryption is deterministic, so commitments match submitted blobs
+	commitments, err := e.Commit(ctx, blobs, ns)
+	require.NoError(t, err)
+	expected, err := dummy.Commit(ctx, stored, ns)
+	require.NoError(t, err)
+	assert.Equal(t, expected, commitments)
+
+	// envelopes are bound to namespace
+	other := da.Namespace("other")
+	copied, err := dummy.Submit(ctx, stored[1:2], 0, other)
+	require.NoError(t, err)
+	_, err = e.Get(ctx, copied, other)
+	assert.ErrorContains(t, err, "failed to decrypt")
+	otherIDs, err := e.Submit(ctx, blobs[1:2], 0, other)
+	require.NoError(t, err)
+	otherStored, err := dummy.Get(ctx, otherIDs, other)
+	require.NoError(t, err)
+	assert.NotEqual(t, stored[1], otherStored[0])
+}
+
+func TestEncryptorKeyRotation(t *testing.T) {
+	ctx := context.TODO()
+	ns := da.Namespace("ns")
+	dummy := test.NewDummyDA()
+	keys := newKeyring(t, "key-1")
+	e := encrypt.New(dummy, keys)
+
+	oldIDs, err := e.Submit(ctx, []da.Blob{[]byte("old")}, 0, ns)
+	require.NoError(t, err)
+
+	key, err := encrypt.GenerateKey()
+	require.NoError(t, err)
+	require.NoError(t, keys.Add("key-2", key))
+	require.NoError(t, keys.SetCurrent("key-2"))
+	newIDs, err := e.Submit(ctx, []da.Blob{[]byte("new")}, 0, ns)
+	require.NoError(t, err)
+
+	ret, err := e.Get(ctx, append(oldIDs, newIDs...), ns)
+	require.NoError(t, err)
+	assert.Equal(t, []da.Blob{[]byte("old"), []byte("new")}, ret)
+
+	// blobs encrypted with removed key can't be read
+	assert.Error(t, keys.Remove("key-2"))
+	require.NoError(t, keys.Remove("key-1"))
+	_, err = e.Get(ctx, oldIDs, ns)
+	assert.ErrorIs(t, err, encrypt.ErrUnknownKey)
+	assert.ErrorContains(t, err, `"key-1"`)
+	_, err = e.Get(ctx, newIDs, ns)
+	assert.NoError(t, err)
+}
+
+func TestEncryptorUnknownKey(t *testing.T) {
+	ctx := context.TODO()
+	ns := da.Namespace("ns")
+	dummy := test.NewDummyDA()
+	ids, err := encrypt.New(dummy, newKeyring(t, "key")).Submit(ctx, []da.Blob{[]byte("secret")}, 0, ns)
+	require.NoError(t, err)
+
+	// keyring without key with ID of the envelope
+	_, err = encrypt.New(dummy, newKeyring(t, "other")).Get(ctx, ids, ns)
+	assert.ErrorIs(t, err, encrypt.ErrUnknownKey)
+	// keyring with different key under the same ID
+	_, err = encrypt.New(dummy, newKeyring(t, "key")).Get(ctx, ids, ns)
+	assert.ErrorContains(t, err, "failed to decrypt")
+
+	// blobs submitted without encryption are rejected
+	plainIDs, err := dummy.Submit(ctx, []da.Blob{[]byte("plain")}, 0, ns)
+	require.NoError(t, err)
+	_, err = encrypt.New(dummy, newKeyring(t, "key")).Get(ctx, plainIDs, ns)
+	assert.ErrorContains(t, err, "not encrypted")
+}
+
+func TestEncryptorMaxBlobSize(t *testing.T) {
+	ctx := context.TODO()
+	ns := da.Namespace("ns")
+	dummy := test.NewDummyDA(test.WithMaxBlobSize(1024))
+	e := encrypt.New(dummy, newKeyring(t, "key-1"))
+
+	maxBlobSize, err := e.MaxBlobSize(ctx)
+	require.NoError(t, err)
+	assert.Less(t, maxBlobSize, uint64(1024))
+	_, err = e.Submit(ctx, []da.Blob{make([]byte, maxBlobSize)}, 0, ns)
+	assert.NoError(t, err)
+	test.BlobSizeOverLimitTest(t, e)
+}
+
+func TestKeyring(t *testing.T) {
+	_, err := encrypt.NewKeyring("key", make([]byte, 16))
+	assert.Error(t, err)
+	keys := newKeyring(t, "key")
+	assert.Error(t, keys.Add(string(make([]byte, 256)), make([]byte, encrypt.KeySize)))
+	assert.ErrorIs(t, keys.SetCurrent("missing"), encrypt.ErrUnknownKey)
+	_, err = keys.Key(context.TODO(), "missing")
+	assert.ErrorIs(t, err, encrypt.ErrUnknownKey)
+}
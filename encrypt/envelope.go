@@ -0,0 +1,114 @@
+package encrypt
+
+import (
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/binary"
+	"errors"
+	"fmt"
+)
+
+// Every blob submitted by Encryptor is an envelope:
+//
+//	magic (2 bytes) | version (1 byte) | key ID length (1 byte) | key ID | nonce (12 bytes) | ciphertext
+//
+// Ciphertext is the blob sealed with AES-256-GCM, using the header (everything before nonce) and namespace as
+// additional data, so envelope can't be moved to another namespace or relabeled with another key ID.
+//
+// Nonce is derived from the key, namespace and blob with HMAC-SHA256 (as in SIV construction), so encryption is
+// deterministic and Commit returns commitments of the same envelopes as submitted by Submit. The only information
+// revealed by this is whether two blobs in namespace are equal.
+
+const (
+	envelopeVersion = 1
+	nonceSize       = 12
+	tagSize         = 16
+)
+
+var (
+	magic = [2]byte{0xda, 0xe0}
+
+	errNotEncrypted = errors.New("blob is not encrypted")
+)
+
+// overhead returns the size added to every blob encrypted with key with given ID.
+func overhead(keyID string) uint64 {
+	return uint64(4 + len(keyID) + nonceSize + tagSize)
+}
+
+// seal encrypts blob with given key into an envelope.
+func seal(keyID string, key []byte, blob []byte, ns []byte) ([]byte, error) {
+	if len(keyID) > maxKeyIDSize {
+		return nil, fmt.Errorf("key ID is longer than %d bytes", maxKeyIDSize)
+	}
+	aead, nonceKey, err := deriveKeys(key)
+	if err != nil {
+		return nil, err
+	}
+
+	mac := hmac.New(sha256.New, nonceKey)
+	mac.Write(binary.AppendUvarint(nil, uint64(len(ns))))
+	mac.Write(ns)
+	mac.Write(blob)
+	nonce := mac.Sum(nil)[:nonceSize]
+
+	envelope := make([]byte, 0, uint64(len(blob))+overhead(keyID))
+	envelope = append(envelope, magic[0], magic[1], envelopeVersion, byte(len(keyID)))
+	envelope = append(envelope, keyID...)
+	header := envelope
+	envelope = append(envelope, nonce...)
+	return aead.Seal(envelope, nonce, blob, additionalData(header, ns)), nil
+}
+
+// parseEnvelope returns key ID of the envelope.
+func parseEnvelope(envelope []byte) (string, error) {
+	if len(envelope) < 4 || envelope[0] != magic[0] || envelope[1] != magic[1] {
+		return "", errNotEncrypted
+	}
+	if envelope[2] != envelopeVersion {
+		return "", fmt.Errorf("unsupported envelope version %d", envelope[2])
+	}
+	size := int(envelope[3])
+	if len(envelope) < 4+size+nonceSize+tagSize {
+		return "", errors.New("malformed envelope")
+	}
+	return string(envelope[4 : 4+size]), nil
+}
+
+// open decrypts envelope with given key.
+func open(key []byte, envelope []byte, ns []byte) ([]byte, error) {
+	aead, _, err := deriveKeys(key)
+	if err != nil {
+		return nil, err
+	}
+	headerSize := 4 + int(envelope[3])
+	header, nonce := envelope[:headerSize], envelope[headerSize:headerSize+nonceSize]
+	return aead.Open([]byte{}, nonce, envelope[headerSize+nonceSize:], additionalData(header, ns))
+}
+
+// deriveKeys derives the AEAD and the nonce key from key, so the same key material isn't used for both.
+func deriveKeys(key []byte) (cipher.AEAD, []byte, error) {
+	if len(key) != KeySize {
+		return nil, nil, fmt.Errorf("invalid key size: %d, expected %d", len(key), KeySize)
+	}
+	derive := func(label string) []byte {
+		mac := hmac.New(sha256.New, key)
+		mac.Write([]byte(label))
+		return mac.Sum(nil)
+	}
+	block, err := aes.NewCipher(derive("go-da encryption key"))
+	if err != nil {
+		return nil, nil, err
+	}
+	aead, err := cipher.NewGCM(block)
+	if err != nil {
+		return nil, nil, err
+	}
+	return aead, derive("go-da nonce key"), nil
+}
+
+func additionalData(header []byte, ns []byte) []byte {
+	return append(append([]byte(nil), header...), ns...)
+}
@@ -0,0 +1,110 @@
+package encrypt
+
+import (
+	"context"
+	"crypto/rand"
+	"errors"
+	"fmt"
+	"sync"
+)
+
+// KeySize is the size of encryption keys.
+const KeySize = 32
+
+// maxKeyIDSize is the max size of key ID, stored in envelope of every blob.
+const maxKeyIDSize = 255
+
+// ErrUnknownKey is returned by KeyProvider for keys it doesn't hold.
+var ErrUnknownKey = errors.New("unknown encryption key")
+
+// KeyProvider provides keys used to encrypt and decrypt blobs. Keys must be KeySize bytes long.
+type KeyProvider interface {
+	// CurrentKey returns the key used to encrypt new blobs, and its ID.
+	CurrentKey(ctx context.Context) (string, []byte, error)
+	// Key returns the key with given ID, or ErrUnknownKey.
+	Key(ctx context.Context, id string) ([]byte, error)
+}
+
+// Keyring is an in-memory KeyProvider, safe for concurrent use.
+//
+// Keys are rotated by adding a new key and making it current; old keys stay available for decryption until removed.
+type Keyring struct {
+	mu      sync.RWMutex
+	current string
+	keys    map[string][]byte
+}
+
+var _ KeyProvider = &Keyring{}
+
+// NewKeyring creates new Keyring holding given key, used as the current one.
+func NewKeyring(id string, key []byte) (*Keyring, error) {
+	k := &Keyring{keys: make(map[string][]byte)}
+	if err := k.Add(id, key); err != nil {
+		return nil, err
+	}
+	k.current = id
+	return k, nil
+}
+
+// GenerateKey returns new random key.
+func GenerateKey() ([]byte, error) {
+	key := make([]byte, KeySize)
+	if _, err := rand.Read(key); err != nil {
+		return nil, err
+	}
+	return key, nil
+}
+
+// Add adds key with given ID, replacing previous key with the same ID.
+func (k *Keyring) Add(id string, key []byte) error {
+	if len(id) > maxKeyIDSize {
+		return fmt.Errorf("key ID is longer than %d bytes", maxKeyIDSize)
+	}
+	if len(key) != KeySize {
+		return fmt.Errorf("invalid key size: %d, expected %d", len(key), KeySize)
+	}
+	k.mu.Lock()
+	defer k.mu.Unlock()
+	k.keys[id] = append([]byte(nil), key...)
+	return nil
+}
+
+// SetCurrent makes key with given ID the one used to encrypt new blobs.
+func (k *Keyring) SetCurrent(id string) error {
+	k.mu.Lock()
+	defer k.mu.Unlock()
+	if _, ok := k.keys[id]; !ok {
+		return fmt.Errorf("%w %q", ErrUnknownKey, id)
+	}
+	k.current = id
+	return nil
+}
+
+// Remove removes key with given ID. The current key can't be removed.
+func (k *Keyring) Remove(id string) error {
+	k.mu.Lock()
+	defer k.mu.Unlock()
+	if id == k.current {
+		return errors.New("current key can't be removed")
+	}
+	delete(k.keys, id)
+	return nil
+}
+
+// CurrentKey returns the key used to encrypt new blobs, and its ID.
+func (k *Keyring) CurrentKey(context.Context) (string, []byte, error) {
+	k.mu.RLock()
+	defer k.mu.RUnlock()
+	return k.current, k.keys[k.current], nil
+}
+
+// Key returns the key with given ID, or ErrUnknownKey.
+func (k *Keyring) Key(_ context.Context, id string) ([]byte, error) {
+	k.mu.RLock()
+	defer k.mu.RUnlock()
+	key, ok := k.keys[id]
+	if !ok {
+		return nil, ErrUnknownKey
+	}
+	return key, nil
+}
@@ -1,6 +1,8 @@
 package da
 
 import (
+	"errors"
+
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 
@@ -24,6 +26,9 @@ const (
 	CodeTxTooLarge                 Code = 32006
 	CodeContextDeadline            Code = 32007
 	CodeFutureHeight               Code = 32008
+	CodeReceiptNotFound            Code = 32009
+	CodeInvalidNamespace           Code = 32010
+	CodeNotSupported               Code = 32011
 )
 
 // ErrBlobNotFound is used to indicate that the blob was not found.
@@ -122,6 +127,100 @@ func (e *ErrFutureHeight) GRPCStatus() *status.Status {
 	return getGRPCStatus(e, codes.OutOfRange, pbda.ErrorCode_ERROR_CODE_FUTURE_HEIGHT)
 }
 
+// ErrReceiptNotFound is returned when submission identified by given receipt is not known to DA.
+type ErrReceiptNotFound struct{}
+
+func (e *ErrReceiptNotFound) Error() string {
+	return "receipt: not found"
+}
+
+// GRPCStatus returns the gRPC status with details for an ErrReceiptNotFound error.
+func (e *ErrReceiptNotFound) GRPCStatus() *status.Status {
+	return getGRPCStatus(e, codes.NotFound, pbda.ErrorCode_ERROR_CODE_RECEIPT_NOT_FOUND)
+}
+
+// ErrInvalidNamespace is returned when given namespace is malformed.
+type ErrInvalidNamespace struct{}
+
+func (e *ErrInvalidNamespace) Error() string {
+	return "namespace: invalid"
+}
+
+// GRPCStatus returns the gRPC status with details for an ErrInvalidNamespace error.
+func (e *ErrInvalidNamespace) GRPCStatus() *status.Status {
+	return getGRPCStatus(e, codes.InvalidArgument, pbda.ErrorCode_ERROR_CODE_INVALID_NAMESPACE)
+}
+
+// ErrNotSupported is returned when DA doesn't implement optional interface of called method.
+type ErrNotSupported struct{}
+
+func (e *ErrNotSupported) Error() string {
+	return "method: not supported"
+}
+
+// GRPCStatus returns the gRPC status with details for an ErrNotSupported error.
+func (e *ErrNotSupported) GRPCStatus() *status.Status {
+	return getGRPCStatus(e, codes.Unimplemented, pbda.ErrorCode_ERROR_CODE_NOT_SUPPORTED)
+}
+
+// IsRetryable returns true if err (or any error it wraps) is a transient DA error, i.e. the call that returned it
+// may succeed if retried: ErrTxTimedOut, ErrTxAlreadyInMempool, ErrTxIncorrectAccountSequence or ErrContextDeadline.
+func IsRetryable(err error) bool {
+	var (
+		txTimedOut                 *ErrTxTimedOut
+		txAlreadyInMempool         *ErrTxAlreadyInMempool
+		txIncorrectAccountSequence *ErrTxIncorrectAccountSequence
+		contextDeadline            *ErrContextDeadline
+	)
+	return errors.As(err, &txTimedOut) ||
+		errors.As(err, &txAlreadyInMempool) ||
+		errors.As(err, &txIncorrectAccountSequence) ||
+		errors.As(err, &contextDeadline)
+}
+
+// CodeOf returns the Code of DA error wrapped in err, or 0 if err doesn't wrap any error defined in this package.
+func CodeOf(err error) Code {
+	var (
+		blobNotFound               *ErrBlobNotFound
+		blobSizeOverLimit          *ErrBlobSizeOverLimit
+		txTimedOut                 *ErrTxTimedOut
+		txAlreadyInMempool         *ErrTxAlreadyInMempool
+		txIncorrectAccountSequence *ErrTxIncorrectAccountSequence
+		txTooLarge                 *ErrTxTooLarge
+		contextDeadline            *ErrContextDeadline
+		futureHeight               *ErrFutureHeight
+		receiptNotFound            *ErrReceiptNotFound
+		invalidNamespace           *ErrInvalidNamespace
+		notSupported               *ErrNotSupported
+	)
+	switch {
+	case errors.As(err, &blobNotFound):
+		return CodeBlobNotFound
+	case errors.As(err, &blobSizeOverLimit):
+		return CodeBlobSizeOverLimit
+	case errors.As(err, &txTimedOut):
+		return CodeTxTimedOut
+	case errors.As(err, &txAlreadyInMempool):
+		return CodeTxAlreadyInMempool
+	case errors.As(err, &txIncorrectAccountSequence):
+		return CodeTxIncorrectAccountSequence
+	case errors.As(err, &txTooLarge):
+		return CodeTxTooLarge
+	case errors.As(err, &contextDeadline):
+		return CodeContextDeadline
+	case errors.As(err, &futureHeight):
+		return CodeFutureHeight
+	case errors.As(err, &receiptNotFound):
+		return CodeReceiptNotFound
+	case errors.As(err, &invalidNamespace):
+		return CodeInvalidNamespace
+	case errors.As(err, &notSupported):
+		return CodeNotSupported
+	default:
+		return 0
+	}
+}
+
 // getGRPCStatus constructs a gRPC status with error details based on the provided error, gRPC code, and DA error code.
 func getGRPCStatus(err error, grpcCode codes.Code, daCode pbda.ErrorCode) *status.Status {
 	base := status.New(grpcCode, err.Error())
@@ -0,0 +1,478 @@
+// Package fanout provides a da.DA posting the same blobs to many DA backends, for redundancy.
+package fanout
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/rollkit/go-da"
+	"github.com/rollkit/go-da/internal/varlist"
+)
+
+// FanOut is a da.DA submitting blobs to many backends in parallel.
+//
+// Submit succeeds once quorum of backends (all of them, by default) succeeded. Cancellation of ctx aborts submissions
+// only until quorum is reached; submissions still in progress afterwards are awaited for straggler wait (see
+// WithStragglerWait), and then continue in background, reporting results to straggler handler (see
+// WithStragglerHandler). Every blob gets a single composite ID, recording IDs of the blob in backends that included it
+// before Submit returned; the same applies to proofs and commitments. Get reads every blob from whichever backend
+// holding it answers first.
+//
+// Heights are specific to backends: GetIDs returns composite IDs of blobs at given height of the first backend,
+// recording only IDs in the first backend. For the same reason FanOut implements da.DA only: backends have no common
+// latest height, subscription or submission receipt to expose.
+type FanOut struct {
+	backends      []da.DA
+	quorum        int
+	stragglerWait time.Duration
+	onStraggler   func(backend int, ids []da.ID, err error)
+}
+
+var _ da.DA = &FanOut{}
+
+// New creates new FanOut submitting to given backends.
+func New(backends []da.DA, opts ...func(*FanOut) *FanOut) *FanOut {
+	f := &FanOut{backends: backends, quorum: len(backends)}
+	for _, opt := range opts {
+		f = opt(f)
+	}
+	return f
+}
+
+// WithQuorum configures the number of backends that must succeed for Submit to succeed.
+func WithQuorum(quorum int) func(*FanOut) *FanOut {
+	return func(f *FanOut) *FanOut {
+		f.quorum = quorum
+		return f
+	}
+}
+
+// WithStragglerWait configures how long Submit waits for remaining backends once quorum is reached, so IDs of blobs
+// in backends finishing in time are recorded in composite IDs. Submit doesn't wait by default.
+func WithStragglerWait(wait time.Duration) func(*FanOut) *FanOut {
+	return func(f *FanOut) *FanOut {
+		f.stragglerWait = wait
+		return f
+	}
+}
+
+// WithStragglerHandler configures a function called with results of submissions not recorded in composite IDs
+// returned by successful Submit: failed (before or after quorum was reached), or finished after Submit returned. ids
+// are IDs of blobs in given backend. The handler is called from a background goroutine, one result at a time.
+func WithStragglerHandler(handler func(backend int, ids []da.ID, err error)) func(*FanOut) *FanOut {
+	return func(f *FanOut) *FanOut {
+		f.onStraggler = handler
+		return f
+	}
+}
+
+// MaxBlobSize returns the smallest max blob size of backends.
+func (f *FanOut) MaxBlobSize(ctx context.Context) (uint64, error) {
+	sizes := make([]uint64, len(f.backends))
+	err := f.all(ctx, func(ctx context.Context, i int, backend da.DA) error {
+		var err error
+		sizes[i], err = backend.MaxBlobSize(ctx)
+		return err
+	})
+	if err != nil {
+		return 0, err
+	}
+	var maxBlobSize uint64
+	for i, size := range sizes {
+		if i == 0 || size < maxBlobSize {
+			maxBlobSize = size
+		}
+	}
+	return maxBlobSize, nil
+}
+
+// Get returns Blobs for given composite IDs, reading every blob from the fastest backend holding it.
+func (f *FanOut) Get(ctx context.Context, ids []da.ID, ns da.Namespace) ([]da.Blob, error) {
+	backendIDs, err := f.decodeComposites(ids)
+	if err != nil {
+		return nil, err
+	}
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	type result struct {
+		backend int
+		blobs   []da.Blob
+		err     error
+	}
+	results := make(chan result, len(f.backends))
+	started := 0
+	for i, backend := range f.backends {
+		// every backend is asked only for blobs it holds
+		_, bIDs := present(backendIDs, i)
+		if len(bIDs) == 0 {
+			continue
+		}
+		started++
+		go func(i int, backend da.DA) {
+			blobs, err := backend.Get(ctx, bIDs, ns)
+			if err == nil && len(blobs) != len(bIDs) {
+				err = errors.New("unexpected number of blobs")
+			}
+			results <- result{backend: i, blobs: blobs, err: err}
+		}(i, backend)
+	}
+
+	blobs := make([]da.Blob, len(ids))
+	found := make([]bool, len(ids))
+	missing := len(ids)
+	var errs []error
+	for ; started > 0 && missing > 0; started-- {
+		r := <-results
+		if r.err != nil {
+			errs = append(errs, fmt.Errorf("backend %d: %w", r.backend, r.err))
+			continue
+		}
+		positions, _ := present(backendIDs, r.backend)
+		for k, j := range positions {
+			if !found[j] {
+				blobs[j], found[j] = r.blobs[k], true
+				missing--
+			}
+		}
+	}
+	if missing > 0 {
+		if len(errs) == 0 {
+			return nil, &da.ErrBlobNotFound{}
+		}
+		return nil, errors.Join(errs...)
+	}
+	return blobs, nil
+}
+
+// GetIDs returns composite IDs of all Blobs located at given height of the first backend.
+func (f *FanOut) GetIDs(ctx context.Context, height uint64, ns da.Namespace) (*da.GetIDsResult, error) {
+	if len(f.backends) == 0 {
+		return nil, errors.New("no backends")
+	}
+	ret, err := f.backends[0].GetIDs(ctx, height, ns)
+	if err != nil || ret == nil {
+		return ret, err
+	}
+	ids := make([]da.ID, len(ret.IDs))
+	for i, id := range ret.IDs {
+		values := make([][]byte, len(f.backends))
+		values[0] = id
+		ids[i] = varlist.Encode(values)
+	}
+	return &da.GetIDsResult{IDs: ids, Timestamp: ret.Timestamp}, nil
+}
+
+// GetProofs returns composite inclusion Proofs for given composite IDs, recording proofs of backends that provided
+// them. Every composite proof holds at least one backend proof.
+func (f *FanOut) GetProofs(ctx context.Context, ids []da.ID, ns da.Namespace) ([]da.Proof, error) {
+	backendIDs, err := f.decodeComposites(ids)
+	if err != nil {
+		return nil, err
+	}
+	proofs := make([][][]byte, len(ids))
+	for j := range proofs {
+		proofs[j] = make([][]byte, len(f.backends))
+	}
+	errs := f.each(ctx, func(ctx context.Context, i int, backend da.DA) error {
+		positions, bIDs := present(backendIDs, i)
+		if len(bIDs) == 0 {
+			return nil
+		}
+		bProofs, err := backend.GetProofs(ctx, bIDs, ns)
+		if err != nil {
+			return err
+		}
+		if len(bProofs) != len(bIDs) {
+			return errors.New("unexpected number of proofs")
+		}
+		for k, j := range positions {
+			proofs[j][i] = bProofs[k]
+		}
+		return nil
+	})
+
+	composites := make([]da.Proof, len(ids))
+	for j := range proofs {
+		if !anyPresent(proofs[j]) {
+			return nil, errors.Join(append([]error{fmt.Errorf("no proof of blob %d", j)}, errs...)...)
+		}
+		composites[j] = varlist.Encode(proofs[j])
+	}
+	return composites, nil
+}
+
+// Commit returns composite Commitments for given blobs, recording commitments of all backends.
+func (f *FanOut) Commit(ctx context.Context, blobs []da.Blob, ns da.Namespace) ([]da.Commitment, error) {
+	commitments := make([][]da.Commitment, len(f.backends))
+	err := f.all(ctx, func(ctx context.Context, i int, backend da.DA) error {
+		c, err := backend.Commit(ctx, blobs, ns)
+		if err == nil && len(c) != len(blobs) {
+			err = errors.New("unexpected number of commitments")
+		}
+		commitments[i] = c
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+	composites := make([]da.Commitment, len(blobs))
+	for j := range blobs {
+		values := make([][]byte, len(f.backends))
+		for i := range f.backends {
+			values[i] = commitments[i][j]
+		}
+		composites[j] = varlist.Encode(values)
+	}
+	return composites, nil
+}
+
+// Submit submits blobs to all backends in parallel, returning composite IDs once quorum of backends succeeded.
+func (f *FanOut) Submit(ctx context.Context, blobs []da.Blob, gasPrice float64, ns da.Namespace) ([]da.ID, error) {
+	return f.submit(ctx, blobs, func(ctx context.Context, backend da.DA) ([]da.ID, error) {
+		return backend.Submit(ctx, blobs, gasPrice, ns)
+	})
+}
+
+// SubmitWithOptions submits blobs to all backends in parallel, returning composite IDs once quorum of backends
+// succeeded.
+func (f *FanOut) SubmitWithOptions(ctx context.Context, blobs []da.Blob, gasPrice float64, ns da.Namespace, options []byte) ([]da.ID, error) {
+	return f.submit(ctx, blobs, func(ctx context.Context, backend da.DA) ([]da.ID, error) {
+		return backend.SubmitWithOptions(ctx, blobs, gasPrice, ns, options)
+	})
+}
+
+// Validate validates composite Proofs for given composite IDs. Result is true only if proofs of all backends
+// recorded in composite proof are valid.
+func (f *FanOut) Validate(ctx context.Context, ids []da.ID, proofs []da.Proof, ns da.Namespace) ([]bool, error) {
+	if len(ids) != len(proofs) {
+		return nil, errors.New("number of IDs doesn't match number of proofs")
+	}
+	backendIDs, err := f.decodeComposites(ids)
+	if err != nil {
+		return nil, err
+	}
+	backendProofs := make([][][]byte, len(proofs))
+	for j := range proofs {
+		backendProofs[j], err = decodeComposite(proofs[j], len(f.backends))
+		if err != nil || !anyPresent(backendProofs[j]) {
+			return nil, fmt.Errorf("malformed composite proof %d", j)
+		}
+	}
+
+	var mu sync.Mutex
+	valid := make([]bool, len(ids))
+	for j := range valid {
+		valid[j] = true
+	}
+	err = f.all(ctx, func(ctx context.Context, i int, backend da.DA) error {
+		var positions []int
+		var bIDs, bProofs [][]byte
+		for j := range ids {
+			if len(backendProofs[j][i]) == 0 {
+				continue
+			}
+			if len(backendIDs[j][i]) == 0 {
+				return fmt.Errorf("composite proof %d has proof of blob not included in backend", j)
+			}
+			positions = append(positions, j)
+			bIDs = append(bIDs, backendIDs[j][i])
+			bProofs = append(bProofs, backendProofs[j][i])
+		}
+		if len(bIDs) == 0 {
+			return nil
+		}
+		results, err := backend.Validate(ctx, bIDs, bProofs, ns)
+		if err != nil {
+			return err
+		}
+		if len(results) != len(bIDs) {
+			return errors.New("unexpected number of validation results")
+		}
+		mu.Lock()
+		defer mu.Unlock()
+		for k, j := range positions {
+			valid[j] = valid[j] && results[k]
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return valid, nil
+}
+
+// submitResult is a result of submission to a single backend.
+type submitResult struct {
+	backend int
+	ids     []da.ID
+	err     error
+}
+
+// submit calls submit for every backend in parallel, until quorum of backends succeeded or quorum can't be reached.
+func (f *FanOut) submit(ctx context.Context, blobs []da.Blob, submit func(context.Context, da.DA) ([]da.ID, error)) ([]da.ID, error) {
+	if f.quorum < 1 || f.quorum > len(f.backends) {
+		return nil, fmt.Errorf("invalid quorum %d of %d backends", f.quorum, len(f.backends))
+	}
+	// submissions outlive ctx once quorum is reached, so remaining backends can still include blobs
+	subCtx, cancel := context.WithCancel(context.WithoutCancel(ctx))
+	stop := context.AfterFunc(ctx, cancel)
+
+	results := make(chan submitResult, len(f.backends))
+	for i, backend := range f.backends {
+		go func(i int, backend da.DA) {
+			ids, err := submit(subCtx, backend)
+			if err == nil && len(ids) != len(blobs) {
+				err = errors.New("unexpected number of IDs")
+			}
+			results <- submitResult{backend: i, ids: ids, err: err}
+		}(i, backend)
+	}
+
+	ids := make([][][]byte, len(blobs))
+	for j := range ids {
+		ids[j] = make([][]byte, len(f.backends))
+	}
+	record := func(r submitResult) {
+		for j, id := range r.ids {
+			ids[j][r.backend] = id
+		}
+	}
+	var errs []error
+	var failed []submitResult // reported to straggler handler, once quorum is reached
+	pending := len(f.backends)
+	for succeeded := 0; succeeded < f.quorum; {
+		r := <-results
+		pending--
+		if r.err != nil {
+			failed = append(failed, r)
+			errs = append(errs, fmt.Errorf("backend %d: %w", r.backend, r.err))
+			if len(f.backends)-len(errs) < f.quorum {
+				stop()
+				cancel()
+				return nil, fmt.Errorf("quorum of %d backends not reached: %w", f.quorum, errors.Join(errs...))
+			}
+			continue
+		}
+		record(r)
+		succeeded++
+	}
+	stop()
+
+	if pending > 0 && f.stragglerWait > 0 {
+		timer := time.NewTimer(f.stragglerWait)
+	wait:
+		for ; pending > 0; pending-- {
+			select {
+			case r := <-results:
+				if r.err != nil {
+					failed = append(failed, r)
+					continue
+				}
+				record(r)
+			case <-timer.C:
+				break wait
+			}
+		}
+		timer.Stop()
+	}
+	go f.drain(failed, results, pending, cancel)
+
+	composites := make([]da.ID, len(blobs))
+	for j := range ids {
+		composites[j] = varlist.Encode(ids[j])
+	}
+	return composites, nil
+}
+
+// drain passes failed results and results of pending submissions to straggler handler, releasing context of
+// submissions once all are in.
+func (f *FanOut) drain(failed []submitResult, results <-chan submitResult, pending int, cancel context.CancelFunc) {
+	defer cancel()
+	for _, r := range failed {
+		f.straggler(r)
+	}
+	for ; pending > 0; pending-- {
+		f.straggler(<-results)
+	}
+}
+
+// straggler reports result of submission not recorded in composite IDs to straggler handler, if configured.
+func (f *FanOut) straggler(r submitResult) {
+	if f.onStraggler != nil {
+		f.onStraggler(r.backend, r.ids, r.err)
+	}
+}
+
+// all calls fn for every backend in parallel, returning joined errors of failed backends, or nil if none failed.
+func (f *FanOut) all(ctx context.Context, fn func(context.Context, int, da.DA) error) error {
+	return errors.Join(f.each(ctx, fn)...)
+}
+
+// each calls fn for every backend in parallel, returning errors of failed backends.
+func (f *FanOut) each(ctx context.Context, fn func(context.Context, int, da.DA) error) []error {
+	results := make(chan error, len(f.backends))
+	for i, backend := range f.backends {
+		go func(i int, backend da.DA) {
+			if err := fn(ctx, i, backend); err != nil {
+				results <- fmt.Errorf("backend %d: %w", i, err)
+				return
+			}
+			results <- nil
+		}(i, backend)
+	}
+	var errs []error
+	for range f.backends {
+		if err := <-results; err != nil {
+			errs = append(errs, err)
+		}
+	}
+	return errs
+}
+
+// decodeComposites decodes backend IDs of every composite ID. Malformed IDs are reported as da.ErrBlobNotFound.
+func (f *FanOut) decodeComposites(ids []da.ID) ([][][]byte, error) {
+	backendIDs := make([][][]byte, len(ids))
+	for j, id := range ids {
+		var err error
+		if backendIDs[j], err = decodeComposite(id, len(f.backends)); err != nil || !anyPresent(backendIDs[j]) {
+			return nil, &da.ErrBlobNotFound{}
+		}
+	}
+	return backendIDs, nil
+}
+
+// present returns positions and values of composites present in given backend.
+func present(composites [][][]byte, backend int) ([]int, [][]byte) {
+	var positions []int
+	var values [][]byte
+	for j, composite := range composites {
+		if len(composite[backend]) > 0 {
+			positions = append(positions, j)
+			values = append(values, composite[backend])
+		}
+	}
+	return positions, values
+}
+
+func anyPresent(values [][]byte) bool {
+	for _, v := range values {
+		if len(v) > 0 {
+			return true
+		}
+	}
+	return false
+}
+
+// decodeComposite decodes values of given number of backends (empty for backends without value), encoded by
+// varlist.Encode.
+func decodeComposite(data []byte, backends int) ([][]byte, error) {
+	values, err := varlist.Decode(data)
+	if err != nil || len(values) != backends {
+		return nil, errors.New("malformed composite")
+	}
+	return values, nil
+}
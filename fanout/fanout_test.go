@@ -0,0 +1,190 @@
+package fanout_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/rollkit/go-da"
+	"github.com/rollkit/go-da/fanout"
+	"github.com/rollkit/go-da/test"
+)
+
+var errBackend = errors.New("backend failure")
+
+func failing(target da.DA, methods ...string) da.DA {
+	return test.NewFaultyDA(target, 1, test.WithFault(test.Fault{ErrorRate: 1, Errors: []error{errBackend}}, methods...))
+}
+
+func slow(target da.DA, methods ...string) da.DA {
+	return test.NewFaultyDA(target, 1, test.WithFault(test.Fault{Latency: time.Second}, methods...))
+}
+
+func TestFanOut(t *testing.T) {
+	test.RunDATestSuite(t, fanout.New([]da.DA{test.NewDummyDA()}))
+
+	// with many backends, GetIDs returns only IDs of the first backend, so they differ from IDs returned by Submit
+	f := fanout.New([]da.DA{test.NewDummyDA(), test.NewDummyDA()})
+	test.BasicDATest(t, f)
+	test.GetIDsTest(t, f)
+	test.CheckErrors(t, f)
+	test.ConcurrentReadWriteTest(t, f)
+	test.HeightFromFutureTest(t, f)
+}
+
+func TestFanOutQuorum(t *testing.T) {
+	ctx := context.TODO()
+	ns := da.Namespace("ns")
+	first, second := test.NewDummyDA(), test.NewDummyDA()
+	backends := []da.DA{first, second, failing(test.NewDummyDA(), "Submit")}
+	blobs := []da.Blob{[]byte("a"), []byte("b")}
+
+	_, err := fanout.New(backends).Submit(ctx, blobs, 0, ns)
+	assert.ErrorIs(t, err, errBackend)
+	_, err = fanout.New(backends, fanout.WithQuorum(4)).Submit(ctx, blobs, 0, ns)
+	assert.Error(t, err)
+
+	f := fanout.New(backends, fanout.WithQuorum(2))
+	ids, err := f.Submit(ctx, blobs, 0, ns)
+	require.NoError(t, err)
+	ret, err := f.Get(ctx, ids, ns)
+	require.NoError(t, err)
+	assert.Equal(t, blobs, ret)
+
+	// blobs are readable from every backend that included them
+	for _, backend := range []da.DA{first, second} {
+		height, err := backend.(*test.DummyDA).LatestHeight(ctx)
+		require.NoError(t, err)
+		backendIDs, err := backend.GetIDs(ctx, height, ns)
+		require.NoError(t, err)
+		ret, err := backend.Get(ctx, backendIDs.IDs, ns)
+		require.NoError(t, err)
+		assert.Equal(t, blobs, ret)
+	}
+
+	proofs, err := f.GetProofs(ctx, ids, ns)
+	require.NoError(t, err)
+	valid, err := f.Validate(ctx, ids, proofs, ns)
+	require.NoError(t, err)
+	assert.Equal(t, []bool{true, true}, valid)
+}
+
+func TestFanOutQuorumReturnsEarly(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	ns := da.Namespace("ns")
+	f := fanout.New([]da.DA{slow(test.NewDummyDA(), "Submit"), test.NewDummyDA()}, fanout.WithQuorum(1))
+
+	start := time.Now()
+	ids, err := f.Submit(ctx, []da.Blob{[]byte("blob")}, 0, ns)
+	require.NoError(t, err)
+	assert.Less(t, time.Since(start), time.Second)
+	ret, err := f.Get(ctx, ids, ns)
+	require.NoError(t, err)
+	assert.Equal(t, []da.Blob{[]byte("blob")}, ret)
+}
+
+func TestFanOutStragglers(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	ns := da.Namespace("ns")
+	blob := []da.Blob{[]byte("blob")}
+	straggler := func(target da.DA) da.DA {
+		return test.NewFaultyDA(target, 1, test.WithFault(test.Fault{Latency: 100 * time.Millisecond}, "Submit"))
+	}
+
+	// slow backend still includes blob after Submit returned, even if ctx is canceled
+	late := test.NewDummyDA()
+	type stragglerResult struct {
+		backend int
+		ids     []da.ID
+		err     error
+	}
+	results := make(chan stragglerResult, 1)
+	handler := fanout.WithStragglerHandler(func(backend int, ids []da.ID, err error) {
+		results <- stragglerResult{backend: backend, ids: ids, err: err}
+	})
+	f := fanout.New([]da.DA{straggler(late), test.NewDummyDA()}, fanout.WithQuorum(1), handler)
+	submitCtx, submitCancel := context.WithCancel(ctx)
+	_, err := f.Submit(submitCtx, blob, 0, ns)
+	require.NoError(t, err)
+	submitCancel()
+	select {
+	case r := <-results:
+		assert.Equal(t, 0, r.backend)
+		assert.NoError(t, r.err)
+		ret, err := late.Get(ctx, r.ids, ns)
+		require.NoError(t, err)
+		assert.Equal(t, blob, ret)
+	case <-ctx.Done():
+		t.Fatal("straggler handler not called")
+	}
+	assert.Eventually(t, func() bool {
+		height, err := late.LatestHeight(ctx)
+		if err != nil || height == 0 {
+			return false
+		}
+		ret, err := late.GetIDs(ctx, height, ns)
+		return err == nil && len(ret.IDs) == 1
+	}, time.Second, 10*time.Millisecond)
+
+	// with straggler wait, IDs of slow backend are recorded in composite IDs
+	late, other := test.NewDummyDA(), test.NewDummyDA()
+	f = fanout.New([]da.DA{straggler(late), other}, fanout.WithQuorum(1), fanout.WithStragglerWait(time.Second))
+	ids, err := f.Submit(ctx, blob, 0, ns)
+	require.NoError(t, err)
+	ret, err := fanout.New([]da.DA{late, failing(other, "Get")}).Get(ctx, ids, ns)
+	require.NoError(t, err)
+	assert.Equal(t, blob, ret)
+
+	// backends failed before quorum was reached are reported as well
+	f = fanout.New([]da.DA{failing(test.NewDummyDA(), "Submit"), straggler(test.NewDummyDA())}, fanout.WithQuorum(1),
+		handler)
+	_, err = f.Submit(ctx, blob, 0, ns)
+	require.NoError(t, err)
+	select {
+	case r := <-results:
+		assert.Equal(t, 0, r.backend)
+		assert.ErrorIs(t, r.err, errBackend)
+	case <-ctx.Done():
+		t.Fatal("straggler handler not called")
+	}
+}
+
+func TestFanOutGetFirstAnswer(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	ns := da.Namespace("ns")
+	first, second := test.NewDummyDA(), test.NewDummyDA()
+	ids, err := fanout.New([]da.DA{first, second}).Submit(ctx, []da.Blob{[]byte("blob")}, 0, ns)
+	require.NoError(t, err)
+
+	// slow or failing backends don't prevent reads from the others
+	for _, backends := range [][]da.DA{
+		{slow(first, "Get"), second},
+		{first, slow(second, "Get")},
+		{failing(first, "Get"), second},
+	} {
+		ret, err := fanout.New(backends).Get(ctx, ids, ns)
+		require.NoError(t, err)
+		assert.Equal(t, []da.Blob{[]byte("blob")}, ret)
+	}
+
+	_, err = fanout.New([]da.DA{failing(first, "Get"), failing(second, "Get")}).Get(ctx, ids, ns)
+	assert.ErrorIs(t, err, errBackend)
+	// composite IDs of other number of backends are not found
+	_, err = fanout.New([]da.DA{first}).Get(ctx, ids, ns)
+	assert.ErrorIs(t, err, &da.ErrBlobNotFound{})
+}
+
+func TestFanOutMaxBlobSize(t *testing.T) {
+	f := fanout.New([]da.DA{test.NewDummyDA(test.WithMaxBlobSize(2048)), test.NewDummyDA(test.WithMaxBlobSize(1024))})
+	maxBlobSize, err := f.MaxBlobSize(context.TODO())
+	require.NoError(t, err)
+	assert.Equal(t, uint64(1024), maxBlobSize)
+	test.BlobSizeOverLimitTest(t, f)
+}
@@ -0,0 +1,47 @@
+// Package varlist encodes lists of byte slices, used by wrappers building composite IDs, proofs and commitments.
+package varlist
+
+import (
+	"encoding/binary"
+	"errors"
+)
+
+// ErrMalformed is returned by Decode for data not encoded by Encode.
+var ErrMalformed = errors.New("malformed list")
+
+// Encode encodes list as a single byte slice: number of elements, followed by length-prefixed elements.
+func Encode(list [][]byte) []byte {
+	size := binary.MaxVarintLen64
+	for _, elem := range list {
+		size += binary.MaxVarintLen64 + len(elem)
+	}
+	buf := binary.AppendUvarint(make([]byte, 0, size), uint64(len(list)))
+	for _, elem := range list {
+		buf = binary.AppendUvarint(buf, uint64(len(elem)))
+		buf = append(buf, elem...)
+	}
+	return buf
+}
+
+// Decode decodes list encoded by Encode. Elements share memory with data.
+func Decode(data []byte) ([][]byte, error) {
+	count, n := binary.Uvarint(data)
+	// every element takes at least 1 byte
+	if n <= 0 || count > uint64(len(data)) {
+		return nil, ErrMalformed
+	}
+	data = data[n:]
+	list := make([][]byte, count)
+	for i := range list {
+		size, n := binary.Uvarint(data)
+		if n <= 0 || size > uint64(len(data)-n) {
+			return nil, ErrMalformed
+		}
+		list[i] = data[n : n+int(size)]
+		data = data[n+int(size):]
+	}
+	if len(data) != 0 {
+		return nil, ErrMalformed
+	}
+	return list, nil
+}
@@ -0,0 +1,39 @@
+package varlist_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/rollkit/go-da/internal/varlist"
+)
+
+func TestRoundTrip(t *testing.T) {
+	for _, list := range [][][]byte{
+		{},
+		{{}},
+		{[]byte("a"), {}, []byte("bc")},
+	} {
+		ret, err := varlist.Decode(varlist.Encode(list))
+		require.NoError(t, err)
+		assert.Len(t, ret, len(list))
+		for i := range list {
+			assert.Equal(t, string(list[i]), string(ret[i]))
+		}
+	}
+}
+
+func TestDecodeMalformed(t *testing.T) {
+	valid := varlist.Encode([][]byte{[]byte("a"), []byte("bc")})
+	for _, data := range [][]byte{
+		nil,
+		{0x80},
+		{0xff, 0x01},
+		valid[:len(valid)-1],
+		append(valid, 0),
+	} {
+		_, err := varlist.Decode(data)
+		assert.ErrorIs(t, err, varlist.ErrMalformed)
+	}
+}
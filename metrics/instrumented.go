@@ -0,0 +1,166 @@
+package metrics
+
+import (
+	"context"
+	"time"
+
+	"github.com/rollkit/go-da"
+)
+
+// Instrumented is a da.DA wrapper recording metrics of every call in Collector.
+//
+// Instrumented exposes every optional interface, so it can wrap DA served by proxies without hiding them: methods not
+// implemented by wrapped DA fail with da.ErrNotSupported, and da.SupportedExtensions reports extensions of wrapped DA
+// (see da.Unwrapper). GetAll and Capabilities fall back to da.GetAll and da.GetCapabilities.
+type Instrumented struct {
+	target    da.DA
+	collector *Collector
+}
+
+var _ da.DA = &Instrumented{}
+var _ da.Subscriber = &Instrumented{}
+var _ da.LatestHeightGetter = &Instrumented{}
+var _ da.AllGetter = &Instrumented{}
+var _ da.SubmissionTracker = &Instrumented{}
+var _ da.GasEstimator = &Instrumented{}
+var _ da.CapabilitiesProvider = &Instrumented{}
+var _ da.NamespaceValidator = &Instrumented{}
+var _ da.Unwrapper = &Instrumented{}
+
+// Wrap creates new Instrumented wrapping target DA, recording metrics in given collector.
+func Wrap(target da.DA, collector *Collector) *Instrumented {
+	return &Instrumented{target: target, collector: collector}
+}
+
+// Unwrap returns the wrapped DA.
+func (i *Instrumented) Unwrap() da.DA {
+	return i.target
+}
+
+// MaxBlobSize returns the max blob size of wrapped DA.
+func (i *Instrumented) MaxBlobSize(ctx context.Context) (maxBlobSize uint64, err error) {
+	defer i.observe("MaxBlobSize", time.Now(), &err)
+	return i.target.MaxBlobSize(ctx)
+}
+
+// Get returns Blobs for given IDs.
+func (i *Instrumented) Get(ctx context.Context, ids []da.ID, ns da.Namespace) (blobs []da.Blob, err error) {
+	defer i.observe("Get", time.Now(), &err)
+	blobs, err = i.target.Get(ctx, ids, ns)
+	if err == nil {
+		i.collector.retrieved("Get", blobs)
+	}
+	return blobs, err
+}
+
+// GetIDs returns IDs of Blobs at given height.
+func (i *Instrumented) GetIDs(ctx context.Context, height uint64, ns da.Namespace) (ret *da.GetIDsResult, err error) {
+	defer i.observe("GetIDs", time.Now(), &err)
+	return i.target.GetIDs(ctx, height, ns)
+}
+
+// GetProofs returns inclusion Proofs for given IDs.
+func (i *Instrumented) GetProofs(ctx context.Context, ids []da.ID, ns da.Namespace) (proofs []da.Proof, err error) {
+	defer i.observe("GetProofs", time.Now(), &err)
+	return i.target.GetProofs(ctx, ids, ns)
+}
+
+// Commit returns Commitments for given blobs.
+func (i *Instrumented) Commit(ctx context.Context, blobs []da.Blob, ns da.Namespace) (commitments []da.Commitment, err error) {
+	defer i.observe("Commit", time.Now(), &err)
+	return i.target.Commit(ctx, blobs, ns)
+}
+
+// Submit submits blobs to wrapped DA.
+func (i *Instrumented) Submit(ctx context.Context, blobs []da.Blob, gasPrice float64, ns da.Namespace) (ids []da.ID, err error) {
+	defer i.observe("Submit", time.Now(), &err)
+	ids, err = i.target.Submit(ctx, blobs, gasPrice, ns)
+	if err == nil {
+		i.collector.submitted("Submit", blobs)
+	}
+	return ids, err
+}
+
+// SubmitWithOptions submits blobs to wrapped DA.
+func (i *Instrumented) SubmitWithOptions(ctx context.Context, blobs []da.Blob, gasPrice float64, ns da.Namespace, options []byte) (ids []da.ID, err error) {
+	defer i.observe("SubmitWithOptions", time.Now(), &err)
+	ids, err = i.target.SubmitWithOptions(ctx, blobs, gasPrice, ns, options)
+	if err == nil {
+		i.collector.submitted("SubmitWithOptions", blobs)
+	}
+	return ids, err
+}
+
+// Validate validates Proofs for given IDs.
+func (i *Instrumented) Validate(ctx context.Context, ids []da.ID, proofs []da.Proof, ns da.Namespace) (results []bool, err error) {
+	defer i.observe("Validate", time.Now(), &err)
+	return i.target.Validate(ctx, ids, proofs, ns)
+}
+
+// Subscribe subscribes to new heights of wrapped DA.
+func (i *Instrumented) Subscribe(ctx context.Context, ns da.Namespace) (events <-chan *da.SubscriptionEvent, err error) {
+	defer i.observe("Subscribe", time.Now(), &err)
+	return da.Subscribe(ctx, i.target, ns)
+}
+
+// LatestHeight returns the latest height of wrapped DA.
+func (i *Instrumented) LatestHeight(ctx context.Context) (height uint64, err error) {
+	defer i.observe("LatestHeight", time.Now(), &err)
+	return da.LatestHeight(ctx, i.target)
+}
+
+// GetAll returns IDs and Blobs at given height.
+func (i *Instrumented) GetAll(ctx context.Context, height uint64, ns da.Namespace) (ret *da.GetAllResult, err error) {
+	defer i.observe("GetAll", time.Now(), &err)
+	ret, err = da.GetAll(ctx, i.target, height, ns)
+	if err == nil && ret != nil {
+		i.collector.retrieved("GetAll", ret.Blobs)
+	}
+	return ret, err
+}
+
+// SubmitWithReceipt submits blobs to wrapped DA asynchronously.
+func (i *Instrumented) SubmitWithReceipt(ctx context.Context, blobs []da.Blob, gasPrice float64, ns da.Namespace, options []byte) (receipt da.Receipt, err error) {
+	defer i.observe("SubmitWithReceipt", time.Now(), &err)
+	receipt, err = da.SubmitWithReceipt(ctx, i.target, blobs, gasPrice, ns, options)
+	if err == nil {
+		i.collector.submitted("SubmitWithReceipt", blobs)
+	}
+	return receipt, err
+}
+
+// GetSubmissionStatus returns the status of submission identified by given Receipt.
+func (i *Instrumented) GetSubmissionStatus(ctx context.Context, receipt da.Receipt) (status *da.SubmissionStatus, err error) {
+	defer i.observe("GetSubmissionStatus", time.Now(), &err)
+	return da.GetSubmissionStatus(ctx, i.target, receipt)
+}
+
+// GasPrice returns the gas price estimated by wrapped DA.
+func (i *Instrumented) GasPrice(ctx context.Context) (gasPrice float64, err error) {
+	defer i.observe("GasPrice", time.Now(), &err)
+	return da.GasPrice(ctx, i.target)
+}
+
+// GasMultiplier returns the gas multiplier of wrapped DA.
+func (i *Instrumented) GasMultiplier(ctx context.Context) (gasMultiplier float64, err error) {
+	defer i.observe("GasMultiplier", time.Now(), &err)
+	return da.GasMultiplier(ctx, i.target)
+}
+
+// Capabilities returns the set of features supported by wrapped DA.
+func (i *Instrumented) Capabilities(ctx context.Context) (capabilities *da.Capabilities, err error) {
+	defer i.observe("Capabilities", time.Now(), &err)
+	return da.GetCapabilities(ctx, i.target)
+}
+
+// ValidateNamespace checks namespace with wrapped DA. All namespaces are valid if it isn't a da.NamespaceValidator.
+func (i *Instrumented) ValidateNamespace(ns da.Namespace) error {
+	if validator, ok := i.target.(da.NamespaceValidator); ok {
+		return validator.ValidateNamespace(ns)
+	}
+	return nil
+}
+
+func (i *Instrumented) observe(method string, start time.Time, err *error) {
+	i.collector.observe(method, start, *err)
+}
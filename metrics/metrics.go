@@ -0,0 +1,159 @@
+// Package metrics provides a da.DA wrapper recording Prometheus metrics of DA calls.
+//
+// The wrapper can be used on client side (wrapping proxy client) and on server side (wrapping DA served by proxy).
+package metrics
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
+
+	"github.com/rollkit/go-da"
+)
+
+// Collector holds metrics recorded by Instrumented DA wrappers. It must be registered in Prometheus registry for
+// metrics to be exported. Single Collector can be shared by many wrappers.
+type Collector struct {
+	calls          *prometheus.CounterVec
+	errors         *prometheus.CounterVec
+	duration       *prometheus.HistogramVec
+	submittedBytes *prometheus.CounterVec
+	submittedBlobs *prometheus.CounterVec
+	retrievedBytes *prometheus.CounterVec
+	retrievedBlobs *prometheus.CounterVec
+}
+
+var _ prometheus.Collector = &Collector{}
+
+// NewCollector creates new Collector. Names of metrics are prefixed with given namespace, for example "da_client".
+func NewCollector(namespace string) *Collector {
+	return &Collector{
+		calls: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Namespace: namespace,
+			Name:      "calls_total",
+			Help:      "Number of DA calls, by method and result code.",
+		}, []string{"method", "code"}),
+		errors: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Namespace: namespace,
+			Name:      "errors_total",
+			Help:      "Number of failed DA calls, by method and DA error code.",
+		}, []string{"method", "code"}),
+		duration: prometheus.NewHistogramVec(prometheus.HistogramOpts{
+			Namespace: namespace,
+			Name:      "call_duration_seconds",
+			Help:      "Latency of DA calls, by method.",
+			Buckets:   prometheus.ExponentialBuckets(0.001, 2, 16),
+		}, []string{"method"}),
+		submittedBytes: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Namespace: namespace,
+			Name:      "submitted_bytes_total",
+			Help:      "Total size of successfully submitted blobs, by method.",
+		}, []string{"method"}),
+		submittedBlobs: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Namespace: namespace,
+			Name:      "submitted_blobs_total",
+			Help:      "Number of successfully submitted blobs, by method.",
+		}, []string{"method"}),
+		retrievedBytes: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Namespace: namespace,
+			Name:      "retrieved_bytes_total",
+			Help:      "Total size of retrieved blobs, by method.",
+		}, []string{"method"}),
+		retrievedBlobs: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Namespace: namespace,
+			Name:      "retrieved_blobs_total",
+			Help:      "Number of retrieved blobs, by method.",
+		}, []string{"method"}),
+	}
+}
+
+// Describe implements prometheus.Collector.
+func (c *Collector) Describe(ch chan<- *prometheus.Desc) {
+	for _, collector := range c.collectors() {
+		collector.Describe(ch)
+	}
+}
+
+// Collect implements prometheus.Collector.
+func (c *Collector) Collect(ch chan<- prometheus.Metric) {
+	for _, collector := range c.collectors() {
+		collector.Collect(ch)
+	}
+}
+
+func (c *Collector) collectors() []prometheus.Collector {
+	return []prometheus.Collector{
+		c.calls, c.errors, c.duration, c.submittedBytes, c.submittedBlobs, c.retrievedBytes, c.retrievedBlobs,
+	}
+}
+
+// observe records a call of method that started at given time.
+func (c *Collector) observe(method string, start time.Time, err error) {
+	c.duration.WithLabelValues(method).Observe(time.Since(start).Seconds())
+	code := ErrorCode(err)
+	c.calls.WithLabelValues(method, code).Inc()
+	if err != nil {
+		c.errors.WithLabelValues(method, code).Inc()
+	}
+}
+
+func (c *Collector) submitted(method string, blobs []da.Blob) {
+	c.submittedBlobs.WithLabelValues(method).Add(float64(len(blobs)))
+	c.submittedBytes.WithLabelValues(method).Add(float64(size(blobs)))
+}
+
+func (c *Collector) retrieved(method string, blobs []da.Blob) {
+	c.retrievedBlobs.WithLabelValues(method).Add(float64(len(blobs)))
+	c.retrievedBytes.WithLabelValues(method).Add(float64(size(blobs)))
+}
+
+// Handler returns HTTP handler exporting metrics registered in given registry, to be served on /metrics endpoint.
+func Handler(gatherer prometheus.Gatherer) http.Handler {
+	return promhttp.HandlerFor(gatherer, promhttp.HandlerOpts{})
+}
+
+// errorLabels maps codes of DA errors to labels reported by ErrorCode.
+var errorLabels = map[da.Code]string{
+	da.CodeBlobNotFound:               "blob_not_found",
+	da.CodeBlobSizeOverLimit:          "blob_size_over_limit",
+	da.CodeTxTimedOut:                 "tx_timed_out",
+	da.CodeTxAlreadyInMempool:         "tx_already_in_mempool",
+	da.CodeTxIncorrectAccountSequence: "tx_incorrect_account_sequence",
+	da.CodeTxTooLarge:                 "tx_too_large",
+	da.CodeContextDeadline:            "context_deadline",
+	da.CodeFutureHeight:               "future_height",
+	da.CodeReceiptNotFound:            "receipt_not_found",
+	da.CodeInvalidNamespace:           "invalid_namespace",
+	da.CodeNotSupported:               "not_supported",
+}
+
+// ErrorCode returns label identifying err: "ok" for nil, name of DA error (e.g. "tx_timed_out") for errors defined
+// in da package, or "unknown".
+func ErrorCode(err error) string {
+	if err == nil {
+		return "ok"
+	}
+	if label, ok := errorLabels[da.CodeOf(err)]; ok {
+		return label
+	}
+	switch {
+	case errors.Is(err, context.DeadlineExceeded):
+		return "context_deadline"
+	case errors.Is(err, context.Canceled):
+		return "canceled"
+	default:
+		return "unknown"
+	}
+}
+
+func size(blobs []da.Blob) int {
+	total := 0
+	for _, blob := range blobs {
+		total += len(blob)
+	}
+	return total
+}
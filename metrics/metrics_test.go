@@ -0,0 +1,132 @@
+package metrics_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/status"
+
+	"github.com/rollkit/go-da"
+	"github.com/rollkit/go-da/metrics"
+	"github.com/rollkit/go-da/proxy/auth"
+	proxygrpc "github.com/rollkit/go-da/proxy/grpc"
+	"github.com/rollkit/go-da/test"
+)
+
+func TestInstrumented(t *testing.T) {
+	collector := metrics.NewCollector("da")
+	test.RunDATestSuite(t, metrics.Wrap(test.NewDummyDA(), collector))
+}
+
+func TestInstrumentedMetrics(t *testing.T) {
+	ctx := context.TODO()
+	registry := prometheus.NewRegistry()
+	collector := metrics.NewCollector("da")
+	registry.MustRegister(collector)
+
+	d := metrics.Wrap(test.NewDummyDA(test.WithMaxBlobSize(10)), collector)
+	ids, err := d.Submit(ctx, []da.Blob{[]byte("first"), []byte("second")}, 0, nil)
+	require.NoError(t, err)
+	_, err = d.Submit(ctx, []da.Blob{make([]byte, 11)}, 0, nil)
+	assert.ErrorIs(t, err, &da.ErrBlobSizeOverLimit{})
+	_, err = d.Get(ctx, ids, nil)
+	require.NoError(t, err)
+	_, err = d.GetIDs(ctx, 100, nil)
+	assert.ErrorIs(t, err, &da.ErrFutureHeight{})
+
+	expected := `
+# HELP da_errors_total Number of failed DA calls, by method and DA error code.
+# TYPE da_errors_total counter
+da_errors_total{code="blob_size_over_limit",method="Submit"} 1
+da_errors_total{code="future_height",method="GetIDs"} 1
+# HELP da_submitted_bytes_total Total size of successfully submitted blobs, by method.
+# TYPE da_submitted_bytes_total counter
+da_submitted_bytes_total{method="Submit"} 11
+# HELP da_retrieved_blobs_total Number of retrieved blobs, by method.
+# TYPE da_retrieved_blobs_total counter
+da_retrieved_blobs_total{method="Get"} 2
+`
+	err = testutil.GatherAndCompare(registry, strings.NewReader(expected),
+		"da_errors_total", "da_submitted_bytes_total", "da_retrieved_blobs_total")
+	assert.NoError(t, err)
+	assert.Equal(t, 3, testutil.CollectAndCount(collector, "da_call_duration_seconds"))
+
+	srv := httptest.NewServer(metrics.Handler(registry))
+	defer srv.Close()
+	resp, err := srv.Client().Get(srv.URL + "/metrics")
+	require.NoError(t, err)
+	defer resp.Body.Close() //nolint:errcheck
+	body, err := io.ReadAll(resp.Body)
+	require.NoError(t, err)
+	assert.Contains(t, string(body), `da_calls_total{code="ok",method="Get"} 1`)
+}
+
+func TestInstrumentedUnsupported(t *testing.T) {
+	ctx := context.TODO()
+	collector := metrics.NewCollector("da")
+	d := metrics.Wrap(struct{ da.DA }{test.NewDummyDA()}, collector)
+
+	_, err := d.Subscribe(ctx, nil)
+	assert.ErrorIs(t, err, &da.ErrNotSupported{})
+	_, err = d.LatestHeight(ctx)
+	assert.ErrorIs(t, err, &da.ErrNotSupported{})
+
+	capabilities, err := d.Capabilities(ctx)
+	require.NoError(t, err)
+	assert.Empty(t, capabilities.Extensions)
+	assert.Empty(t, da.SupportedExtensions(d))
+
+	ret, err := d.GetAll(ctx, 0, nil)
+	assert.NoError(t, err)
+	assert.Nil(t, ret)
+
+	test.RunDATestSuite(t, d)
+}
+
+// TestInstrumentedUnsupportedProxy ensures that proxy serving Instrumented reports unsupported methods as such.
+func TestInstrumentedUnsupportedProxy(t *testing.T) {
+	ctx := context.TODO()
+	d := metrics.Wrap(struct{ da.DA }{test.NewDummyDA()}, metrics.NewCollector("da"))
+	server := proxygrpc.NewServer(d, grpc.Creds(insecure.NewCredentials()),
+		proxygrpc.WithUnauthenticatedAccess(auth.AllPerms...))
+	lis, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	go func() {
+		_ = server.Serve(lis)
+	}()
+	defer server.Stop()
+
+	client := proxygrpc.NewClient()
+	require.NoError(t, client.Start(lis.Addr().String(), grpc.WithTransportCredentials(insecure.NewCredentials())))
+	defer client.Stop() //nolint:errcheck
+	_, err = client.LatestHeight(ctx)
+	assert.ErrorIs(t, err, &da.ErrNotSupported{})
+	_, err = client.GasPrice(ctx)
+	s, ok := status.FromError(err)
+	require.True(t, ok)
+	assert.Equal(t, codes.Unimplemented, s.Code())
+
+	test.RunDATestSuite(t, client)
+}
+
+func TestErrorCode(t *testing.T) {
+	assert.Equal(t, "ok", metrics.ErrorCode(nil))
+	assert.Equal(t, "tx_timed_out", metrics.ErrorCode(fmt.Errorf("wrapped: %w", &da.ErrTxTimedOut{})))
+	assert.Equal(t, "context_deadline", metrics.ErrorCode(context.DeadlineExceeded))
+	assert.Equal(t, "canceled", metrics.ErrorCode(context.Canceled))
+	assert.Equal(t, "not_supported", metrics.ErrorCode(&da.ErrNotSupported{}))
+	assert.Equal(t, "unknown", metrics.ErrorCode(errors.New("unknown")))
+}
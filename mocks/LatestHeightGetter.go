@@ -0,0 +1,92 @@
+// Code generated by mockery v2.46.0. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+
+	mock "github.com/stretchr/testify/mock"
+)
+
+// MockLatestHeightGetter is an autogenerated mock type for the LatestHeightGetter type
+type MockLatestHeightGetter struct {
+	mock.Mock
+}
+
+type MockLatestHeightGetter_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockLatestHeightGetter) EXPECT() *MockLatestHeightGetter_Expecter {
+	return &MockLatestHeightGetter_Expecter{mock: &_m.Mock}
+}
+
+// LatestHeight provides a mock function with given fields: ctx
+func (_m *MockLatestHeightGetter) LatestHeight(ctx context.Context) (uint64, error) {
+	ret := _m.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for LatestHeight")
+	}
+
+	var r0 uint64
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context) (uint64, error)); ok {
+		return rf(ctx)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context) uint64); ok {
+		r0 = rf(ctx)
+	} else {
+		r0 = ret.Get(0).(uint64)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockLatestHeightGetter_LatestHeight_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'LatestHeight'
+type MockLatestHeightGetter_LatestHeight_Call struct {
+	*mock.Call
+}
+
+// LatestHeight is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *MockLatestHeightGetter_Expecter) LatestHeight(ctx interface{}) *MockLatestHeightGetter_LatestHeight_Call {
+	return &MockLatestHeightGetter_LatestHeight_Call{Call: _e.mock.On("LatestHeight", ctx)}
+}
+
+func (_c *MockLatestHeightGetter_LatestHeight_Call) Run(run func(ctx context.Context)) *MockLatestHeightGetter_LatestHeight_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context))
+	})
+	return _c
+}
+
+func (_c *MockLatestHeightGetter_LatestHeight_Call) Return(_a0 uint64, _a1 error) *MockLatestHeightGetter_LatestHeight_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockLatestHeightGetter_LatestHeight_Call) RunAndReturn(run func(context.Context) (uint64, error)) *MockLatestHeightGetter_LatestHeight_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockLatestHeightGetter creates a new instance of MockLatestHeightGetter. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockLatestHeightGetter(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockLatestHeightGetter {
+	mock := &MockLatestHeightGetter{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,143 @@
+// Package namespace provides constructors, validation and parsing helpers for common layouts of da.Namespace.
+package namespace
+
+import (
+	"bytes"
+	"encoding/binary"
+	"encoding/hex"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/rollkit/go-da"
+)
+
+const (
+	// CelestiaVersionSize is the size of version prefix of Celestia-style namespace.
+	CelestiaVersionSize = 1
+	// CelestiaIDSize is the size of ID of Celestia-style namespace.
+	CelestiaIDSize = 28
+	// CelestiaSize is the size of Celestia-style namespace.
+	CelestiaSize = CelestiaVersionSize + CelestiaIDSize
+
+	// CelestiaVersionZero is the version of namespaces available for user data.
+	CelestiaVersionZero uint8 = 0
+	// CelestiaVersionMax is the version of namespaces reserved by the protocol.
+	CelestiaVersionMax uint8 = 255
+	// CelestiaV0SubIDSize is the number of trailing bytes of ID that can be set in version zero namespaces. Remaining
+	// bytes must be zeros.
+	CelestiaV0SubIDSize = 10
+
+	// AppIDSize is the size of numeric AppID namespace (used by Avail).
+	AppIDSize = 4
+)
+
+// celestiaV0Prefix is the required prefix of ID of version zero namespaces.
+var celestiaV0Prefix = make([]byte, CelestiaIDSize-CelestiaV0SubIDSize)
+
+// Validator returns an error if given namespace is malformed.
+//
+// Errors returned by validators in this package wrap da.ErrInvalidNamespace.
+type Validator func(ns da.Namespace) error
+
+// NewRaw returns a namespace holding a copy of given bytes, without any validation.
+func NewRaw(b []byte) da.Namespace {
+	return append(da.Namespace{}, b...)
+}
+
+// NewCelestia returns Celestia-style namespace with given version and ID.
+func NewCelestia(version uint8, id []byte) (da.Namespace, error) {
+	ns := make(da.Namespace, 0, CelestiaSize)
+	ns = append(ns, version)
+	ns = append(ns, id...)
+	if err := ValidateCelestia(ns); err != nil {
+		return nil, err
+	}
+	return ns, nil
+}
+
+// NewCelestiaV0 returns version zero Celestia-style namespace. Sub ID is left-padded with zeros.
+func NewCelestiaV0(subID []byte) (da.Namespace, error) {
+	if len(subID) > CelestiaV0SubIDSize {
+		return nil, invalid("sub ID must be at most %d bytes, got %d", CelestiaV0SubIDSize, len(subID))
+	}
+	id := make([]byte, CelestiaIDSize)
+	copy(id[CelestiaIDSize-len(subID):], subID)
+	return NewCelestia(CelestiaVersionZero, id)
+}
+
+// NewAppID returns numeric AppID namespace, encoded as big-endian integer.
+func NewAppID(appID uint32) da.Namespace {
+	ns := make(da.Namespace, AppIDSize)
+	binary.BigEndian.PutUint32(ns, appID)
+	return ns
+}
+
+// AppID returns numeric AppID encoded in namespace.
+func AppID(ns da.Namespace) (uint32, error) {
+	if err := ValidateAppID(ns); err != nil {
+		return 0, err
+	}
+	return binary.BigEndian.Uint32(ns), nil
+}
+
+// ValidateCelestia checks if ns is well-formed Celestia-style namespace.
+func ValidateCelestia(ns da.Namespace) error {
+	if err := ValidateSize(CelestiaSize)(ns); err != nil {
+		return err
+	}
+	version, id := ns[0], ns[CelestiaVersionSize:]
+	switch version {
+	case CelestiaVersionZero:
+		if !bytes.HasPrefix(id, celestiaV0Prefix) {
+			return invalid("version zero ID must start with %d zero bytes", len(celestiaV0Prefix))
+		}
+	case CelestiaVersionMax:
+	default:
+		return invalid("unsupported version %d", version)
+	}
+	return nil
+}
+
+// ValidateAppID checks if ns is well-formed numeric AppID namespace.
+func ValidateAppID(ns da.Namespace) error {
+	return ValidateSize(AppIDSize)(ns)
+}
+
+// ValidateSize returns a Validator accepting only namespaces of given size.
+func ValidateSize(size int) Validator {
+	return func(ns da.Namespace) error {
+		if len(ns) != size {
+			return invalid("expected %d bytes, got %d", size, len(ns))
+		}
+		return nil
+	}
+}
+
+// String returns hex representation of namespace.
+func String(ns da.Namespace) string {
+	return hex.EncodeToString(ns)
+}
+
+// ParseHex parses hex representation of namespace. Optional "0x" prefix is accepted.
+func ParseHex(s string) (da.Namespace, error) {
+	s = strings.TrimPrefix(strings.TrimPrefix(s, "0x"), "0X")
+	ns, err := hex.DecodeString(s)
+	if err != nil {
+		return nil, invalid("%s", err)
+	}
+	return ns, nil
+}
+
+// ParseAppID parses decimal representation of numeric AppID namespace.
+func ParseAppID(s string) (da.Namespace, error) {
+	appID, err := strconv.ParseUint(s, 10, 32)
+	if err != nil {
+		return nil, invalid("%s", err)
+	}
+	return NewAppID(uint32(appID)), nil
+}
+
+func invalid(format string, args ...interface{}) error {
+	return fmt.Errorf("%w: %s", &da.ErrInvalidNamespace{}, fmt.Sprintf(format, args...))
+}
@@ -0,0 +1,75 @@
+package namespace_test
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/rollkit/go-da"
+	"github.com/rollkit/go-da/namespace"
+)
+
+func TestCelestia(t *testing.T) {
+	ns, err := namespace.NewCelestiaV0([]byte("rollkit"))
+	require.NoError(t, err)
+	assert.Len(t, ns, namespace.CelestiaSize)
+	assert.Equal(t, namespace.CelestiaVersionZero, ns[0])
+	assert.True(t, bytes.HasSuffix(ns, []byte("rollkit")))
+	assert.NoError(t, namespace.ValidateCelestia(ns))
+
+	_, err = namespace.NewCelestiaV0(make([]byte, namespace.CelestiaV0SubIDSize+1))
+	assert.ErrorIs(t, err, &da.ErrInvalidNamespace{})
+
+	_, err = namespace.NewCelestia(namespace.CelestiaVersionZero, bytes.Repeat([]byte{1}, namespace.CelestiaIDSize))
+	assert.ErrorIs(t, err, &da.ErrInvalidNamespace{})
+
+	_, err = namespace.NewCelestia(namespace.CelestiaVersionMax, bytes.Repeat([]byte{1}, namespace.CelestiaIDSize))
+	assert.NoError(t, err)
+
+	_, err = namespace.NewCelestia(1, make([]byte, namespace.CelestiaIDSize))
+	assert.ErrorIs(t, err, &da.ErrInvalidNamespace{})
+
+	assert.ErrorIs(t, namespace.ValidateCelestia(make([]byte, 200)), &da.ErrInvalidNamespace{})
+}
+
+func TestAppID(t *testing.T) {
+	ns := namespace.NewAppID(42)
+	assert.Equal(t, da.Namespace{0, 0, 0, 42}, ns)
+
+	appID, err := namespace.AppID(ns)
+	require.NoError(t, err)
+	assert.Equal(t, uint32(42), appID)
+
+	_, err = namespace.AppID([]byte{1, 2})
+	assert.ErrorIs(t, err, &da.ErrInvalidNamespace{})
+
+	parsed, err := namespace.ParseAppID("42")
+	require.NoError(t, err)
+	assert.Equal(t, ns, parsed)
+
+	_, err = namespace.ParseAppID("4294967296")
+	assert.ErrorIs(t, err, &da.ErrInvalidNamespace{})
+}
+
+func TestRaw(t *testing.T) {
+	b := []byte("test")
+	ns := namespace.NewRaw(b)
+	b[0] = 'x'
+	assert.Equal(t, da.Namespace("test"), ns)
+}
+
+func TestHex(t *testing.T) {
+	ns := da.Namespace{0xde, 0xad, 0xbe, 0xef}
+	assert.Equal(t, "deadbeef", namespace.String(ns))
+
+	for _, s := range []string{"deadbeef", "0xdeadbeef", "0XDEADBEEF"} {
+		parsed, err := namespace.ParseHex(s)
+		require.NoError(t, err)
+		assert.Equal(t, ns, parsed)
+	}
+
+	_, err := namespace.ParseHex("not hex")
+	assert.ErrorIs(t, err, &da.ErrInvalidNamespace{})
+}
@@ -0,0 +1,112 @@
+// Package auth provides token authentication and permissions shared by gRPC and JSON-RPC proxies.
+package auth
+
+import (
+	"context"
+	"time"
+
+	"github.com/filecoin-project/go-jsonrpc/auth"
+	"github.com/golang-jwt/jwt/v5"
+)
+
+// Permission is granted to tokens, and required to call methods of DA.
+type Permission = auth.Permission
+
+// Permissions required by methods of DA.
+const (
+	PermRead  Permission = "read"
+	PermWrite Permission = "write"
+)
+
+// AllPerms contains all permissions, granting access to every method of DA.
+var AllPerms = []Permission{PermRead, PermWrite}
+
+// ReadPerms contains permissions granting access to methods of DA not modifying its state.
+var ReadPerms = []Permission{PermRead}
+
+// MethodPerms maps names of DA methods (including methods of optional interfaces) to permissions required to call
+// them.
+var MethodPerms = map[string]Permission{
+	"MaxBlobSize":         PermRead,
+	"Get":                 PermRead,
+	"GetIDs":              PermRead,
+	"GetProofs":           PermRead,
+	"Commit":              PermRead,
+	"Validate":            PermRead,
+	"Submit":              PermWrite,
+	"SubmitWithOptions":   PermWrite,
+	"Subscribe":           PermRead,
+	"LatestHeight":        PermRead,
+	"GetAll":              PermRead,
+	"SubmitWithReceipt":   PermWrite,
+	"GetSubmissionStatus": PermRead,
+	"GasPrice":            PermRead,
+	"GasMultiplier":       PermRead,
+	"Capabilities":        PermRead,
+}
+
+// Verifier verifies auth tokens sent by clients, returning permissions granted to the token.
+type Verifier interface {
+	Verify(ctx context.Context, token string) ([]Permission, error)
+}
+
+// JWTPayload is the payload of JWT tokens verified by JWTVerifier. Allow lists permissions granted to the token.
+//
+// Payload format is compatible with tokens issued by celestia-node.
+type JWTPayload struct {
+	Allow []Permission
+	jwt.RegisteredClaims
+}
+
+// JWTVerifier verifies JWT tokens signed with HMAC secret.
+type JWTVerifier struct {
+	secret []byte
+	parser *jwt.Parser
+}
+
+var _ Verifier = &JWTVerifier{}
+
+// NewJWTVerifier creates new JWTVerifier accepting tokens signed with given secret.
+func NewJWTVerifier(secret []byte) *JWTVerifier {
+	return &JWTVerifier{
+		secret: secret,
+		parser: jwt.NewParser(jwt.WithValidMethods([]string{"HS256", "HS384", "HS512"})),
+	}
+}
+
+// Verify checks signature and expiration time (if any) of token, and returns permissions granted to it.
+func (v *JWTVerifier) Verify(_ context.Context, token string) ([]Permission, error) {
+	var payload JWTPayload
+	_, err := v.parser.ParseWithClaims(token, &payload, func(*jwt.Token) (interface{}, error) {
+		return v.secret, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return payload.Allow, nil
+}
+
+// NewJWTToken creates new token signed with given secret using HS256, granting given permissions. If ttl is
+// positive, the token expires after ttl.
+func NewJWTToken(secret []byte, perms []Permission, ttl time.Duration) (string, error) {
+	payload := JWTPayload{Allow: perms}
+	if ttl > 0 {
+		payload.ExpiresAt = jwt.NewNumericDate(time.Now().Add(ttl))
+	}
+	return jwt.NewWithClaims(jwt.SigningMethodHS256, &payload).SignedString(secret)
+}
+
+// HasPerm returns true if perms include the permission required to call given DA method. Unknown methods are never
+// allowed.
+func HasPerm(perms []Permission, method string) bool {
+	required, ok := MethodPerms[method]
+	if !ok {
+		return false
+	}
+	for _, perm := range perms {
+		if perm == required {
+			return true
+		}
+	}
+	return false
+}
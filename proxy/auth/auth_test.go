@@ -0,0 +1,39 @@
+package auth_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/rollkit/go-da/proxy/auth"
+)
+
+func TestJWTVerifier(t *testing.T) {
+	ctx := context.TODO()
+	secret := []byte("secret")
+	verifier := auth.NewJWTVerifier(secret)
+
+	token, err := auth.NewJWTToken(secret, auth.ReadPerms, time.Hour)
+	require.NoError(t, err)
+	perms, err := verifier.Verify(ctx, token)
+	require.NoError(t, err)
+	assert.Equal(t, auth.ReadPerms, perms)
+
+	token, err = auth.NewJWTToken([]byte("other"), auth.AllPerms, 0)
+	require.NoError(t, err)
+	_, err = verifier.Verify(ctx, token)
+	assert.Error(t, err)
+	_, err = verifier.Verify(ctx, "not a token")
+	assert.Error(t, err)
+}
+
+func TestHasPerm(t *testing.T) {
+	assert.True(t, auth.HasPerm(auth.ReadPerms, "Get"))
+	assert.False(t, auth.HasPerm(auth.ReadPerms, "Submit"))
+	assert.True(t, auth.HasPerm(auth.AllPerms, "Submit"))
+	assert.False(t, auth.HasPerm(auth.AllPerms, "Unknown"))
+	assert.False(t, auth.HasPerm(nil, "Get"))
+}
@@ -2,10 +2,12 @@ package proxy
 
 import (
 	"context"
+	"crypto/tls"
 	"fmt"
 	"net/url"
 
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
 	"google.golang.org/grpc/credentials/insecure"
 
 	"github.com/rollkit/go-da"
@@ -13,27 +15,90 @@ import (
 	proxyjsonrpc "github.com/rollkit/go-da/proxy/jsonrpc"
 )
 
+// Options configures clients created by NewClient.
+type Options struct {
+	// TLSConfig is used by grpcs connections. If nil, server certificate is verified using system roots.
+	TLSConfig *tls.Config
+}
+
+// WithTLSConfig sets TLS config used by grpcs connections, e.g. to use custom CA or client certificate (see
+// proxygrpc.NewClientTLSConfig).
+func WithTLSConfig(cfg *tls.Config) func(*Options) *Options {
+	return func(o *Options) *Options {
+		o.TLSConfig = cfg
+		return o
+	}
+}
+
+// Client is a DA backend created by NewClient. Close must be called to release its connections.
+type Client interface {
+	da.DA
+	Close() error
+}
+
+// grpcClient adapts proxygrpc.Client to Client.
+type grpcClient struct {
+	*proxygrpc.Client
+}
+
+// Close stops the gRPC client.
+func (c grpcClient) Close() error {
+	return c.Stop()
+}
+
+// jsonrpcClient adapts proxyjsonrpc.Client to Client.
+type jsonrpcClient struct {
+	*proxyjsonrpc.API
+	client *proxyjsonrpc.Client
+}
+
+// Close closes connections of the JSON-RPC client.
+func (c jsonrpcClient) Close() error {
+	c.client.Close()
+	return nil
+}
+
 // NewClient returns a DA backend based on the uri
-// and auth token. Supported schemes: grpc, http, https
-func NewClient(uri, token string) (da.DA, error) {
+// and auth token. Supported schemes: grpc, grpcs, http, https, ws, wss
+//
+// grpcs connects to gRPC server over TLS. For gRPC connections, non-empty token is sent as bearer token with every
+// call.
+func NewClient(uri, token string, opts ...func(*Options) *Options) (Client, error) {
 	addr, err := url.Parse(uri)
 	if err != nil {
 		return nil, err
 	}
-	var client da.DA
+	options := &Options{}
+	for _, opt := range opts {
+		options = opt(options)
+	}
+	var client Client
 	switch addr.Scheme {
-	case "grpc":
-		grpcClient := proxygrpc.NewClient()
-		if err := grpcClient.Start(addr.Host, grpc.WithTransportCredentials(insecure.NewCredentials())); err != nil {
+	case "grpc", "grpcs":
+		var dialOpts []grpc.DialOption
+		if addr.Scheme == "grpcs" {
+			tlsConfig := options.TLSConfig
+			if tlsConfig == nil {
+				tlsConfig = &tls.Config{MinVersion: tls.VersionTLS12}
+			}
+			dialOpts = append(dialOpts, grpc.WithTransportCredentials(credentials.NewTLS(tlsConfig)))
+		} else {
+			dialOpts = append(dialOpts, grpc.WithTransportCredentials(insecure.NewCredentials()))
+		}
+		if token != "" {
+			dialOpts = append(dialOpts, grpc.WithPerRPCCredentials(proxygrpc.NewTokenCredentials(token, addr.Scheme == "grpcs")))
+		}
+		c := proxygrpc.NewClient()
+		if err := c.Start(addr.Host, dialOpts...); err != nil {
 			return nil, err
 		}
-		client = grpcClient
-	case "http", "https":
-		jsonrpcClient, err := proxyjsonrpc.NewClient(context.Background(), uri, token)
+		client = grpcClient{c}
+	case "http", "https", "ws", "wss":
+		c, err := proxyjsonrpc.NewClient(context.Background(), uri, token)
 		if err != nil {
 			return nil, err
 		}
-		client = &jsonrpcClient.DA
+		client = jsonrpcClient{API: &c.DA, client: c}
 	default:
 		return nil, fmt.Errorf("unknown url scheme '%s'", addr.Scheme)
 	}
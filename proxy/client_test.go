@@ -0,0 +1,128 @@
+package proxy_test
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"math/big"
+	"net"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
+
+	"github.com/rollkit/go-da"
+	"github.com/rollkit/go-da/proxy"
+	"github.com/rollkit/go-da/proxy/auth"
+	proxygrpc "github.com/rollkit/go-da/proxy/grpc"
+	proxyjsonrpc "github.com/rollkit/go-da/proxy/jsonrpc"
+	"github.com/rollkit/go-da/test"
+)
+
+func TestNewClientGRPCS(t *testing.T) {
+	ctx := context.Background()
+	dir := t.TempDir()
+	ca, caKey := writeCert(t, dir, "ca", nil, nil)
+	writeCert(t, dir, "server", ca, caKey)
+	writeCert(t, dir, "client", ca, caKey)
+	file := func(name string) string {
+		return filepath.Join(dir, name)
+	}
+
+	secret := []byte("secret")
+	serverTLS, err := proxygrpc.NewServerTLSConfig(file("server.pem"), file("server.key"), file("ca.pem"))
+	require.NoError(t, err)
+	server := proxygrpc.NewServer(test.NewDummyDA(), proxygrpc.WithVerifier(auth.NewJWTVerifier(secret)),
+		grpc.Creds(credentials.NewTLS(serverTLS)))
+	lis, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	go func() {
+		_ = server.Serve(lis)
+	}()
+	defer server.Stop()
+	uri := "grpcs://" + lis.Addr().String()
+
+	token, err := auth.NewJWTToken(secret, auth.AllPerms, 0)
+	require.NoError(t, err)
+	clientTLS, err := proxygrpc.NewClientTLSConfig(file("ca.pem"), file("client.pem"), file("client.key"))
+	require.NoError(t, err)
+	client, err := proxy.NewClient(uri, token, proxy.WithTLSConfig(clientTLS))
+	require.NoError(t, err)
+	_, err = client.Submit(ctx, []da.Blob{[]byte("blob")}, 0, nil)
+	assert.NoError(t, err)
+	assert.NoError(t, client.Close())
+
+	// client certificate is required
+	clientTLS, err = proxygrpc.NewClientTLSConfig(file("ca.pem"), "", "")
+	require.NoError(t, err)
+	client, err = proxy.NewClient(uri, token, proxy.WithTLSConfig(clientTLS))
+	require.NoError(t, err)
+	_, err = client.MaxBlobSize(ctx)
+	assert.Error(t, err)
+	assert.NoError(t, client.Close())
+
+	// server certificate is not trusted by system roots
+	client, err = proxy.NewClient(uri, token)
+	require.NoError(t, err)
+	_, err = client.MaxBlobSize(ctx)
+	assert.Error(t, err)
+	assert.NoError(t, client.Close())
+}
+
+func TestNewClientJSONRPC(t *testing.T) {
+	ctx := context.Background()
+	server := proxyjsonrpc.NewServer("127.0.0.1", "0", test.NewDummyDA(),
+		proxyjsonrpc.WithUnauthenticatedAccess(auth.AllPerms...))
+	require.NoError(t, server.Start(ctx))
+	defer server.Stop(ctx) //nolint:errcheck
+
+	client, err := proxy.NewClient("ws://"+server.Addr().String(), "")
+	require.NoError(t, err)
+	test.RunDATestSuite(t, client)
+	assert.NotEmpty(t, da.SupportedExtensions(client))
+	assert.NoError(t, client.Close())
+}
+
+// writeCert creates certificate with ECDSA key, valid for 127.0.0.1, and writes both to dir in PEM format. If parent
+// is nil, certificate is a self-signed CA.
+func writeCert(t *testing.T, dir, name string, parent *x509.Certificate, parentKey *ecdsa.PrivateKey) (*x509.Certificate, *ecdsa.PrivateKey) {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+	template := &x509.Certificate{
+		SerialNumber: big.NewInt(time.Now().UnixNano()),
+		Subject:      pkix.Name{CommonName: name},
+		NotBefore:    time.Now().Add(-time.Hour),
+		NotAfter:     time.Now().Add(time.Hour),
+		IPAddresses:  []net.IP{net.ParseIP("127.0.0.1")},
+		KeyUsage:     x509.KeyUsageDigitalSignature,
+		ExtKeyUsage:  []x509.ExtKeyUsage{x509.ExtKeyUsageServerAuth, x509.ExtKeyUsageClientAuth},
+	}
+	if parent == nil {
+		template.IsCA = true
+		template.BasicConstraintsValid = true
+		template.KeyUsage |= x509.KeyUsageCertSign
+		parent, parentKey = template, key
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, parent, &key.PublicKey, parentKey)
+	require.NoError(t, err)
+	keyDER, err := x509.MarshalECPrivateKey(key)
+	require.NoError(t, err)
+
+	certPEM := pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der})
+	keyPEM := pem.EncodeToMemory(&pem.Block{Type: "EC PRIVATE KEY", Bytes: keyDER})
+	require.NoError(t, os.WriteFile(filepath.Join(dir, name+".pem"), certPEM, 0600))
+	require.NoError(t, os.WriteFile(filepath.Join(dir, name+".key"), keyPEM, 0600))
+
+	cert, err := x509.ParseCertificate(der)
+	require.NoError(t, err)
+	return cert, key
+}
@@ -0,0 +1,150 @@
+package grpc
+
+import (
+	"context"
+	"strings"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+
+	"github.com/rollkit/go-da/proxy/auth"
+)
+
+// Bearer tokens are sent in "authorization" metadata, in the same format as HTTP Authorization header used by
+// JSON-RPC. Tokens are verified by auth.Verifier, and permissions are enforced as declared in auth.MethodPerms.
+
+const (
+	authorizationKey = "authorization"
+	servicePrefix    = "/da.DAService/"
+)
+
+// methodName returns the name of DA method served by gRPC method with given full name.
+func methodName(fullMethod string) string {
+	name := strings.TrimPrefix(fullMethod, servicePrefix)
+	if name == "GetIds" {
+		return "GetIDs"
+	}
+	return name
+}
+
+// tokenCredentials sends bearer token with every call.
+type tokenCredentials struct {
+	token      string
+	requireTLS bool
+}
+
+// NewTokenCredentials returns credentials sending given bearer token with every call, to be used with
+// grpc.WithPerRPCCredentials. If requireTLS is true, the token is never sent over insecure connections.
+func NewTokenCredentials(token string, requireTLS bool) credentials.PerRPCCredentials {
+	return &tokenCredentials{token: token, requireTLS: requireTLS}
+}
+
+func (c *tokenCredentials) GetRequestMetadata(context.Context, ...string) (map[string]string, error) {
+	return map[string]string{authorizationKey: "Bearer " + c.token}, nil
+}
+
+func (c *tokenCredentials) RequireTransportSecurity() bool {
+	return c.requireTLS
+}
+
+// authOption is a grpc.ServerOption configuring authentication of Server created by NewServer. It's ignored by
+// gRPC itself.
+type authOption struct {
+	grpc.EmptyServerOption
+	apply func(*authenticator)
+}
+
+// WithVerifier configures Server to authenticate calls carrying a bearer token using given verifier. Calls with
+// invalid tokens are rejected with codes.Unauthenticated.
+func WithVerifier(verifier auth.Verifier) grpc.ServerOption {
+	return authOption{apply: func(a *authenticator) {
+		a.verifier = verifier
+	}}
+}
+
+// WithUnauthenticatedAccess grants given permissions to calls without a token. By default, unauthenticated calls
+// can't invoke any method; use auth.AllPerms to disable authentication entirely.
+func WithUnauthenticatedAccess(perms ...auth.Permission) grpc.ServerOption {
+	return authOption{apply: func(a *authenticator) {
+		a.defaultPerms = perms
+	}}
+}
+
+// newAuthenticator creates authenticator configured by authOptions found in opts.
+func newAuthenticator(opts []grpc.ServerOption) *authenticator {
+	a := &authenticator{}
+	for _, opt := range opts {
+		if o, ok := opt.(authOption); ok {
+			o.apply(a)
+		}
+	}
+	return a
+}
+
+// authenticator enforces permissions required by called methods, as declared in auth.MethodPerms. Calls without
+// required permission are rejected with codes.PermissionDenied.
+type authenticator struct {
+	verifier     auth.Verifier
+	defaultPerms []auth.Permission
+}
+
+func (a *authenticator) unaryInterceptor(
+	ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler,
+) (interface{}, error) {
+	if err := a.authorize(ctx, info.FullMethod); err != nil {
+		return nil, err
+	}
+	return handler(ctx, req)
+}
+
+func (a *authenticator) streamInterceptor(
+	srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler,
+) error {
+	if err := a.authorize(ss.Context(), info.FullMethod); err != nil {
+		return err
+	}
+	return handler(srv, ss)
+}
+
+// authorize returns an error if call of method can't be made with permissions granted to token from ctx.
+func (a *authenticator) authorize(ctx context.Context, method string) error {
+	perms, err := a.permissions(ctx)
+	if err != nil {
+		return err
+	}
+	name := methodName(method)
+	required, ok := auth.MethodPerms[name]
+	if !ok || !strings.HasPrefix(method, servicePrefix) {
+		return status.Errorf(codes.PermissionDenied, "unknown method '%s'", method)
+	}
+	if !auth.HasPerm(perms, name) {
+		return status.Errorf(codes.PermissionDenied, "missing permission to invoke '%s' (need '%s')", method, required)
+	}
+	return nil
+}
+
+// permissions returns permissions granted to token from incoming metadata of ctx.
+func (a *authenticator) permissions(ctx context.Context) ([]auth.Permission, error) {
+	values := metadata.ValueFromIncomingContext(ctx, authorizationKey)
+	if len(values) == 0 {
+		return a.defaultPerms, nil
+	}
+	token, ok := strings.CutPrefix(values[0], "Bearer ")
+	if !ok {
+		return nil, status.Error(codes.Unauthenticated, "missing Bearer prefix in authorization metadata")
+	}
+	if token == "" {
+		return a.defaultPerms, nil
+	}
+	if a.verifier == nil {
+		return nil, status.Error(codes.Unauthenticated, "token authentication is not enabled")
+	}
+	perms, err := a.verifier.Verify(ctx, token)
+	if err != nil {
+		return nil, status.Errorf(codes.Unauthenticated, "invalid token: %s", err)
+	}
+	return perms, nil
+}
@@ -11,6 +11,10 @@ import (
 )
 
 // Client is a gRPC proxy client for DA interface.
+//
+// Client also implements optional da.Subscriber, da.LatestHeightGetter, da.SubmissionTracker and da.GasEstimator
+// interfaces, but calls are successful only if the DA served by remote server supports them. da.AllGetter is always
+// supported, as server composes the result if needed. The same applies to da.CapabilitiesProvider.
 type Client struct {
 	conn *grpc.ClientConn
 
@@ -23,7 +27,13 @@ func NewClient() *Client {
 }
 
 // Start connects Client to target, with given options.
+//
+// Trace context is propagated to the server, using the global OpenTelemetry propagator.
 func (c *Client) Start(target string, opts ...grpc.DialOption) (err error) {
+	opts = append([]grpc.DialOption{
+		grpc.WithChainUnaryInterceptor(tracingUnaryClientInterceptor),
+		grpc.WithChainStreamInterceptor(tracingStreamClientInterceptor),
+	}, opts...)
 	c.conn, err = grpc.NewClient(target, opts...)
 	if err != nil {
 		return err
@@ -162,3 +172,146 @@ func (c *Client) Validate(ctx context.Context, ids []da.ID, proofs []da.Proof, n
 	resp, err := c.client.Validate(ctx, req)
 	return resp.Results, tryToMapError(err)
 }
+
+// Subscribe returns a channel delivering every new height.
+func (c *Client) Subscribe(ctx context.Context, namespace da.Namespace) (<-chan *da.SubscriptionEvent, error) {
+	req := &pbda.SubscribeRequest{Namespace: &pbda.Namespace{Value: namespace}}
+	stream, err := c.client.Subscribe(ctx, req)
+	if err != nil {
+		return nil, tryToMapError(err)
+	}
+
+	// server sends headers once subscription is established; in case of failure, error is returned by Recv
+	if md, _ := stream.Header(); md == nil {
+		_, err := stream.Recv()
+		return nil, tryToMapError(err)
+	}
+
+	events := make(chan *da.SubscriptionEvent)
+	go func() {
+		defer close(events)
+		for {
+			resp, err := stream.Recv()
+			if err != nil {
+				return
+			}
+			timestamp, err := types.TimestampFromProto(resp.Timestamp)
+			if err != nil {
+				return
+			}
+			event := &da.SubscriptionEvent{
+				Height:       resp.Height,
+				GetIDsResult: da.GetIDsResult{IDs: idsPB2DA(resp.Ids), Timestamp: timestamp},
+			}
+			select {
+			case events <- event:
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+	return events, nil
+}
+
+// LatestHeight returns the height of the latest block available in DA.
+func (c *Client) LatestHeight(ctx context.Context) (uint64, error) {
+	req := &pbda.LatestHeightRequest{}
+	resp, err := c.client.LatestHeight(ctx, req)
+	if err != nil {
+		return 0, tryToMapError(err)
+	}
+	return resp.Height, nil
+}
+
+// GetAll returns IDs and Blobs of all Blobs located in DA at given height.
+func (c *Client) GetAll(ctx context.Context, height uint64, namespace da.Namespace) (*da.GetAllResult, error) {
+	req := &pbda.GetAllRequest{Height: height, Namespace: &pbda.Namespace{Value: namespace}}
+	resp, err := c.client.GetAll(ctx, req)
+	if err != nil {
+		return nil, tryToMapError(err)
+	}
+
+	// server doesn't send timestamp if there are no blobs at given height
+	if resp.Timestamp == nil {
+		return nil, nil
+	}
+
+	timestamp, err := types.TimestampFromProto(resp.Timestamp)
+	if err != nil {
+		return nil, err
+	}
+	return &da.GetAllResult{IDs: idsPB2DA(resp.Ids), Blobs: blobsPB2DA(resp.Blobs), Timestamp: timestamp}, nil
+}
+
+// SubmitWithReceipt submits the Blobs to Data Availability layer, without waiting for inclusion.
+func (c *Client) SubmitWithReceipt(ctx context.Context, blobs []da.Blob, gasPrice float64, namespace da.Namespace, options []byte) (da.Receipt, error) {
+	req := &pbda.SubmitRequest{
+		Blobs:     blobsDA2PB(blobs),
+		GasPrice:  gasPrice,
+		Namespace: &pbda.Namespace{Value: namespace},
+		Options:   options,
+	}
+
+	resp, err := c.client.SubmitWithReceipt(ctx, req)
+	if err != nil {
+		return nil, tryToMapError(err)
+	}
+	return resp.Receipt.GetValue(), nil
+}
+
+// GetSubmissionStatus returns the status of submission identified by given Receipt.
+func (c *Client) GetSubmissionStatus(ctx context.Context, receipt da.Receipt) (*da.SubmissionStatus, error) {
+	req := &pbda.GetSubmissionStatusRequest{Receipt: &pbda.Receipt{Value: receipt}}
+	resp, err := c.client.GetSubmissionStatus(ctx, req)
+	if err != nil {
+		return nil, tryToMapError(err)
+	}
+	return &da.SubmissionStatus{
+		State:  da.SubmissionState(resp.State),
+		Height: resp.Height,
+		IDs:    idsPB2DA(resp.Ids),
+		Error:  resp.Error,
+	}, nil
+}
+
+// GasPrice returns the gas price estimated by DA.
+func (c *Client) GasPrice(ctx context.Context) (float64, error) {
+	req := &pbda.GasPriceRequest{}
+	resp, err := c.client.GasPrice(ctx, req)
+	if err != nil {
+		return 0, tryToMapError(err)
+	}
+	return resp.GasPrice, nil
+}
+
+// GasMultiplier returns the multiplier applied to gas price on resubmission.
+func (c *Client) GasMultiplier(ctx context.Context) (float64, error) {
+	req := &pbda.GasMultiplierRequest{}
+	resp, err := c.client.GasMultiplier(ctx, req)
+	if err != nil {
+		return 0, tryToMapError(err)
+	}
+	return resp.GasMultiplier, nil
+}
+
+// Capabilities returns the set of features supported by DA.
+func (c *Client) Capabilities(ctx context.Context) (*da.Capabilities, error) {
+	req := &pbda.CapabilitiesRequest{}
+	resp, err := c.client.Capabilities(ctx, req)
+	if err != nil {
+		return nil, tryToMapError(err)
+	}
+
+	blockTime, err := types.DurationFromProto(resp.BlockTime)
+	if err != nil {
+		return nil, err
+	}
+	return &da.Capabilities{
+		NamespaceSize: resp.NamespaceSize,
+		OptionsSchema: resp.OptionsSchema,
+		ProofType:     resp.ProofType,
+		MaxBlobSize:   resp.MaxBlobSize,
+		BlockTime:     blockTime,
+		Extensions:    resp.Extensions,
+	}, nil
+}
@@ -47,6 +47,12 @@ func errorForCode(code pbda.ErrorCode) error {
 		return &da.ErrContextDeadline{}
 	case pbda.ErrorCode_ERROR_CODE_FUTURE_HEIGHT:
 		return &da.ErrFutureHeight{}
+	case pbda.ErrorCode_ERROR_CODE_RECEIPT_NOT_FOUND:
+		return &da.ErrReceiptNotFound{}
+	case pbda.ErrorCode_ERROR_CODE_INVALID_NAMESPACE:
+		return &da.ErrInvalidNamespace{}
+	case pbda.ErrorCode_ERROR_CODE_NOT_SUPPORTED:
+		return &da.ErrNotSupported{}
 	default:
 		return errors.New("unknown error code")
 	}
@@ -1,28 +1,151 @@
 package grpc_test
 
 import (
+	"context"
 	"net"
 	"testing"
 
+	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/status"
 
+	"github.com/rollkit/go-da"
+	"github.com/rollkit/go-da/namespace"
+	"github.com/rollkit/go-da/proxy/auth"
 	proxy "github.com/rollkit/go-da/proxy/grpc"
 	"github.com/rollkit/go-da/test"
 )
 
 func TestProxy(t *testing.T) {
 	dummy := test.NewDummyDA()
-	server := proxy.NewServer(dummy, grpc.Creds(insecure.NewCredentials()))
+	client := startClient(t, startServer(t, dummy))
+	test.RunDATestSuite(t, client)
+}
+
+func TestProxyNamespaceValidation(t *testing.T) {
+	dummy := test.NewDummyDA(test.WithNamespaceValidator(namespace.ValidateAppID))
+	client := startClient(t, startServer(t, dummy))
+	test.NamespaceValidationTest(t, client, namespace.NewAppID(42), make([]byte, 200))
+}
+
+func TestProxySizeLimits(t *testing.T) {
+	dummy := test.NewDummyDA(test.WithMaxBlobSize(1024), test.WithMaxBlockSize(4096))
+	client := startClient(t, startServer(t, dummy))
+	test.BlobSizeOverLimitTest(t, client)
+	test.TxTooLargeTest(t, client, 4096)
+}
+
+func TestProxyAuth(t *testing.T) {
+	ctx := context.Background()
+	secret := []byte("secret")
+	addr := startServer(t, test.NewDummyDA(), proxy.WithVerifier(auth.NewJWTVerifier(secret)),
+		proxy.WithUnauthenticatedAccess())
+
+	newClient := func(token string) *proxy.Client {
+		if token == "" {
+			return startClient(t, addr)
+		}
+		return startClient(t, addr, grpc.WithPerRPCCredentials(proxy.NewTokenCredentials(token, false)))
+	}
+	newToken := func(secret []byte, perms ...auth.Permission) string {
+		token, err := auth.NewJWTToken(secret, perms, 0)
+		require.NoError(t, err)
+		return token
+	}
+	assertCode := func(code codes.Code, err error) {
+		s, ok := status.FromError(err)
+		require.True(t, ok, err)
+		assert.Equal(t, code, s.Code())
+	}
+
+	// unauthenticated calls and invalid tokens are rejected, also by stream methods
+	_, err := newClient("").MaxBlobSize(ctx)
+	assertCode(codes.PermissionDenied, err)
+	_, err = newClient("").Subscribe(ctx, nil)
+	assertCode(codes.PermissionDenied, err)
+	_, err = newClient(newToken([]byte("other"), auth.AllPerms...)).MaxBlobSize(ctx)
+	assertCode(codes.Unauthenticated, err)
+
+	// read token can query and subscribe, but can't submit
+	client := newClient(newToken(secret, auth.PermRead))
+	_, err = client.MaxBlobSize(ctx)
+	assert.NoError(t, err)
+	_, err = client.Submit(ctx, []da.Blob{[]byte("blob")}, 0, nil)
+	assertCode(codes.PermissionDenied, err)
+	_, err = client.Subscribe(ctx, nil)
+	assert.NoError(t, err)
+
+	client = newClient(newToken(secret, auth.AllPerms...))
+	_, err = client.Submit(ctx, []da.Blob{[]byte("blob")}, 0, nil)
+	assert.NoError(t, err)
+	_, err = client.GetIDs(ctx, 1, nil)
+	assert.NoError(t, err)
+}
+
+func TestProxyUnauthenticatedAccess(t *testing.T) {
+	ctx := context.Background()
+	client := startClient(t, startServer(t, test.NewDummyDA(), proxy.WithUnauthenticatedAccess(auth.PermRead)))
+	_, err := client.MaxBlobSize(ctx)
+	assert.NoError(t, err)
+	_, err = client.Submit(ctx, []da.Blob{[]byte("blob")}, 0, nil)
+	s, ok := status.FromError(err)
+	require.True(t, ok)
+	assert.Equal(t, codes.PermissionDenied, s.Code())
+
+	// without auth options, every call is rejected
+	server := proxy.NewServer(test.NewDummyDA(), grpc.Creds(insecure.NewCredentials()))
+	client = startClient(t, serve(t, server))
+	_, err = client.MaxBlobSize(ctx)
+	s, ok = status.FromError(err)
+	require.True(t, ok)
+	assert.Equal(t, codes.PermissionDenied, s.Code())
+}
+
+// startServer serves d over gRPC on random local port, allowing unauthenticated access unless opts override it. It
+// returns the address of server.
+func startServer(t *testing.T, d da.DA, opts ...grpc.ServerOption) string {
+	opts = append([]grpc.ServerOption{
+		grpc.Creds(insecure.NewCredentials()),
+		proxy.WithUnauthenticatedAccess(auth.AllPerms...),
+	}, opts...)
+	return serve(t, proxy.NewServer(d, opts...))
+}
+
+// serve serves server on random local port, until the test finishes. It returns the address of server.
+func serve(t *testing.T, server *grpc.Server) string {
 	lis, err := net.Listen("tcp", "127.0.0.1:0")
 	require.NoError(t, err)
 	go func() {
 		_ = server.Serve(lis)
 	}()
+	t.Cleanup(server.Stop)
+	return lis.Addr().String()
+}
 
+// startClient returns a client connected to addr, stopped when the test finishes.
+func startClient(t *testing.T, addr string, opts ...grpc.DialOption) *proxy.Client {
+	opts = append([]grpc.DialOption{grpc.WithTransportCredentials(insecure.NewCredentials())}, opts...)
 	client := proxy.NewClient()
-	err = client.Start(lis.Addr().String(), grpc.WithTransportCredentials(insecure.NewCredentials()))
-	require.NoError(t, err)
-	test.RunDATestSuite(t, client)
+	require.NoError(t, client.Start(addr, opts...))
+	t.Cleanup(func() {
+		require.NoError(t, client.Stop())
+	})
+	return client
+}
+
+// TestMethodPerms ensures that every method of gRPC service requires a permission.
+func TestMethodPerms(t *testing.T) {
+	server := proxy.NewServer(test.NewDummyDA())
+	methods := server.GetServiceInfo()["da.DAService"].Methods
+	require.NotEmpty(t, methods)
+	for _, method := range methods {
+		name := method.Name
+		if name == "GetIds" {
+			name = "GetIDs"
+		}
+		assert.Contains(t, auth.MethodPerms, name)
+	}
 }
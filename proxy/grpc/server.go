@@ -5,13 +5,23 @@ import (
 
 	"github.com/cosmos/gogoproto/types"
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
 
 	"github.com/rollkit/go-da"
 	pbda "github.com/rollkit/go-da/types/pb/da"
 )
 
 // NewServer creates new gRPC Server configured to serve DA proxy.
+//
+// Trace context propagated by clients is extracted using the global OpenTelemetry propagator. Calls are
+// authenticated as configured by WithVerifier and WithUnauthenticatedAccess options; without them, every call is
+// rejected.
 func NewServer(d da.DA, opts ...grpc.ServerOption) *grpc.Server {
+	a := newAuthenticator(opts)
+	opts = append([]grpc.ServerOption{
+		grpc.ChainUnaryInterceptor(tracingUnaryServerInterceptor, a.unaryInterceptor),
+		grpc.ChainStreamInterceptor(tracingStreamServerInterceptor, a.streamInterceptor),
+	}, opts...)
 	srv := grpc.NewServer(opts...)
 
 	proxy := &proxySrv{target: d}
@@ -21,6 +31,11 @@ func NewServer(d da.DA, opts ...grpc.ServerOption) *grpc.Server {
 	return srv
 }
 
+// proxySrv forwards calls to target DA, including methods of optional interfaces; calls of methods not implemented by
+// target fail with da.ErrNotSupported, reported as codes.Unimplemented.
+//
+// If target implements da.NamespaceValidator, requests with malformed namespaces are rejected with
+// da.ErrInvalidNamespace.
 type proxySrv struct {
 	target da.DA
 }
@@ -33,12 +48,20 @@ func (p *proxySrv) MaxBlobSize(
 }
 
 func (p *proxySrv) Get(ctx context.Context, request *pbda.GetRequest) (*pbda.GetResponse, error) {
+	if err := p.validateNamespace(request.Namespace.GetValue()); err != nil {
+		return nil, err
+	}
+
 	ids := idsPB2DA(request.Ids)
 	blobs, err := p.target.Get(ctx, ids, request.Namespace.GetValue())
 	return &pbda.GetResponse{Blobs: blobsDA2PB(blobs)}, err
 }
 
 func (p *proxySrv) GetIds(ctx context.Context, request *pbda.GetIdsRequest) (*pbda.GetIdsResponse, error) {
+	if err := p.validateNamespace(request.Namespace.GetValue()); err != nil {
+		return nil, err
+	}
+
 	ret, err := p.target.GetIDs(ctx, request.Height, request.Namespace.GetValue())
 	if err != nil {
 		return nil, err
@@ -57,6 +80,10 @@ func (p *proxySrv) GetIds(ctx context.Context, request *pbda.GetIdsRequest) (*pb
 }
 
 func (p *proxySrv) Commit(ctx context.Context, request *pbda.CommitRequest) (*pbda.CommitResponse, error) {
+	if err := p.validateNamespace(request.Namespace.GetValue()); err != nil {
+		return nil, err
+	}
+
 	blobs := blobsPB2DA(request.Blobs)
 	commits, err := p.target.Commit(ctx, blobs, request.Namespace.GetValue())
 	if err != nil {
@@ -67,6 +94,10 @@ func (p *proxySrv) Commit(ctx context.Context, request *pbda.CommitRequest) (*pb
 }
 
 func (p *proxySrv) GetProofs(ctx context.Context, request *pbda.GetProofsRequest) (*pbda.GetProofsResponse, error) {
+	if err := p.validateNamespace(request.Namespace.GetValue()); err != nil {
+		return nil, err
+	}
+
 	ids := idsPB2DA(request.Ids)
 	proofs, err := p.target.GetProofs(ctx, ids, request.Namespace.GetValue())
 	if err != nil {
@@ -77,6 +108,10 @@ func (p *proxySrv) GetProofs(ctx context.Context, request *pbda.GetProofsRequest
 }
 
 func (p *proxySrv) Submit(ctx context.Context, request *pbda.SubmitRequest) (*pbda.SubmitResponse, error) {
+	if err := p.validateNamespace(request.Namespace.GetValue()); err != nil {
+		return nil, err
+	}
+
 	blobs := blobsPB2DA(request.Blobs)
 
 	ids, err := p.target.SubmitWithOptions(ctx, blobs, request.GasPrice, request.Namespace.GetValue(), request.Options)
@@ -96,6 +131,10 @@ func (p *proxySrv) Submit(ctx context.Context, request *pbda.SubmitRequest) (*pb
 }
 
 func (p *proxySrv) Validate(ctx context.Context, request *pbda.ValidateRequest) (*pbda.ValidateResponse, error) {
+	if err := p.validateNamespace(request.Namespace.GetValue()); err != nil {
+		return nil, err
+	}
+
 	ids := idsPB2DA(request.Ids)
 	proofs := proofsPB2DA(request.Proofs)
 	//TODO implement me
@@ -105,3 +144,130 @@ func (p *proxySrv) Validate(ctx context.Context, request *pbda.ValidateRequest)
 	}
 	return &pbda.ValidateResponse{Results: validity}, nil
 }
+
+func (p *proxySrv) Subscribe(request *pbda.SubscribeRequest, stream pbda.DAService_SubscribeServer) error {
+	if err := p.validateNamespace(request.Namespace.GetValue()); err != nil {
+		return err
+	}
+
+	events, err := da.Subscribe(stream.Context(), p.target, request.Namespace.GetValue())
+	if err != nil {
+		return err
+	}
+
+	// headers are sent explicitly, so client knows that subscription is established
+	if err := stream.SendHeader(metadata.MD{}); err != nil {
+		return err
+	}
+
+	for event := range events {
+		timestamp, err := types.TimestampProto(event.Timestamp)
+		if err != nil {
+			return err
+		}
+		resp := &pbda.SubscribeResponse{Height: event.Height, Ids: idsDA2PB(event.IDs), Timestamp: timestamp}
+		if err := stream.Send(resp); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (p *proxySrv) LatestHeight(ctx context.Context, request *pbda.LatestHeightRequest) (*pbda.LatestHeightResponse, error) {
+	height, err := da.LatestHeight(ctx, p.target)
+	if err != nil {
+		return nil, err
+	}
+	return &pbda.LatestHeightResponse{Height: height}, nil
+}
+
+func (p *proxySrv) GetAll(ctx context.Context, request *pbda.GetAllRequest) (*pbda.GetAllResponse, error) {
+	if err := p.validateNamespace(request.Namespace.GetValue()); err != nil {
+		return nil, err
+	}
+
+	ret, err := da.GetAll(ctx, p.target, request.Height, request.Namespace.GetValue())
+	if err != nil {
+		return nil, err
+	}
+
+	// for ErrBlobNotFound cases
+	if ret == nil {
+		return &pbda.GetAllResponse{Ids: []*pbda.ID{}, Blobs: []*pbda.Blob{}, Timestamp: nil}, nil
+	}
+
+	timestamp, err := types.TimestampProto(ret.Timestamp)
+	if err != nil {
+		return nil, err
+	}
+	return &pbda.GetAllResponse{Ids: idsDA2PB(ret.IDs), Blobs: blobsDA2PB(ret.Blobs), Timestamp: timestamp}, nil
+}
+
+func (p *proxySrv) SubmitWithReceipt(ctx context.Context, request *pbda.SubmitRequest) (*pbda.SubmitWithReceiptResponse, error) {
+	if err := p.validateNamespace(request.Namespace.GetValue()); err != nil {
+		return nil, err
+	}
+
+	blobs := blobsPB2DA(request.Blobs)
+	receipt, err := da.SubmitWithReceipt(ctx, p.target, blobs, request.GasPrice, request.Namespace.GetValue(), request.Options)
+	if err != nil {
+		return nil, err
+	}
+	return &pbda.SubmitWithReceiptResponse{Receipt: &pbda.Receipt{Value: receipt}}, nil
+}
+
+func (p *proxySrv) GetSubmissionStatus(ctx context.Context, request *pbda.GetSubmissionStatusRequest) (*pbda.GetSubmissionStatusResponse, error) {
+	ret, err := da.GetSubmissionStatus(ctx, p.target, request.Receipt.GetValue())
+	if err != nil {
+		return nil, err
+	}
+	return &pbda.GetSubmissionStatusResponse{
+		State:  pbda.SubmissionState(ret.State),
+		Height: ret.Height,
+		Ids:    idsDA2PB(ret.IDs),
+		Error:  ret.Error,
+	}, nil
+}
+
+func (p *proxySrv) GasPrice(ctx context.Context, request *pbda.GasPriceRequest) (*pbda.GasPriceResponse, error) {
+	gasPrice, err := da.GasPrice(ctx, p.target)
+	if err != nil {
+		return nil, err
+	}
+	return &pbda.GasPriceResponse{GasPrice: gasPrice}, nil
+}
+
+func (p *proxySrv) GasMultiplier(ctx context.Context, request *pbda.GasMultiplierRequest) (*pbda.GasMultiplierResponse, error) {
+	gasMultiplier, err := da.GasMultiplier(ctx, p.target)
+	if err != nil {
+		return nil, err
+	}
+	return &pbda.GasMultiplierResponse{GasMultiplier: gasMultiplier}, nil
+}
+
+func (p *proxySrv) Capabilities(ctx context.Context, request *pbda.CapabilitiesRequest) (*pbda.CapabilitiesResponse, error) {
+	capabilities, err := da.GetCapabilities(ctx, p.target)
+	if err != nil {
+		return nil, err
+	}
+	return &pbda.CapabilitiesResponse{
+		NamespaceSize: capabilities.NamespaceSize,
+		OptionsSchema: capabilities.OptionsSchema,
+		ProofType:     capabilities.ProofType,
+		MaxBlobSize:   capabilities.MaxBlobSize,
+		BlockTime:     types.DurationProto(capabilities.BlockTime),
+		Extensions:    capabilities.Extensions,
+	}, nil
+}
+
+// validateNamespace returns da.ErrInvalidNamespace if target implements da.NamespaceValidator and rejects ns.
+func (p *proxySrv) validateNamespace(ns da.Namespace) error {
+	validator, ok := p.target.(da.NamespaceValidator)
+	if !ok {
+		return nil
+	}
+	if err := validator.ValidateNamespace(ns); err != nil {
+		return &da.ErrInvalidNamespace{}
+	}
+	return nil
+}
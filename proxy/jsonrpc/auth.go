@@ -0,0 +1,60 @@
+package jsonrpc
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"reflect"
+
+	rpcauth "github.com/filecoin-project/go-jsonrpc/auth"
+
+	"github.com/rollkit/go-da/proxy/auth"
+)
+
+// WithVerifier configures Server to authenticate requests carrying a bearer token using given verifier. Requests
+// with invalid tokens are rejected with 401 Unauthorized.
+func WithVerifier(verifier auth.Verifier) func(*Server) *Server {
+	return func(s *Server) *Server {
+		s.verifier = verifier
+		return s
+	}
+}
+
+// WithUnauthenticatedAccess grants given permissions to requests without a token. By default, unauthenticated
+// requests can't call any method; use auth.AllPerms to disable authentication entirely.
+func WithUnauthenticatedAccess(perms ...auth.Permission) func(*Server) *Server {
+	return func(s *Server) *Server {
+		s.defaultPerms = perms
+		return s
+	}
+}
+
+// authHandler authenticates requests using verifier of s, before passing them to next.
+func (s *Server) authHandler(next http.Handler) http.Handler {
+	return &rpcauth.Handler{Verify: s.verify, Next: next.ServeHTTP}
+}
+
+func (s *Server) verify(ctx context.Context, token string) ([]auth.Permission, error) {
+	// "Bearer " header with empty token is sent by clients created without a token
+	if token == "" {
+		return s.defaultPerms, nil
+	}
+	if s.verifier == nil {
+		return nil, errors.New("token authentication is not enabled")
+	}
+	return s.verifier.Verify(ctx, token)
+}
+
+// getInternalStruct returns pointer to Internal field of API-like struct pointed by api.
+func getInternalStruct(api interface{}) (interface{}, error) {
+	v := reflect.ValueOf(api)
+	if v.Kind() != reflect.Pointer || v.IsNil() || v.Elem().Kind() != reflect.Struct {
+		return nil, fmt.Errorf("expected pointer to API struct, got %T", api)
+	}
+	internal := v.Elem().FieldByName("Internal")
+	if !internal.IsValid() || internal.Kind() != reflect.Struct {
+		return nil, fmt.Errorf("%T has no Internal struct field", api)
+	}
+	return internal.Addr().Interface(), nil
+}
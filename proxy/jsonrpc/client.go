@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 	"net/http"
+	"strings"
 
 	"github.com/filecoin-project/go-jsonrpc"
 
@@ -17,18 +18,28 @@ type Module interface {
 	da.DA
 }
 
-// API defines the jsonrpc service module API
+// API defines the jsonrpc service module API. Perm tags must match auth.MethodPerms.
 type API struct {
 	Internal struct {
-		MaxBlobSize       func(ctx context.Context) (uint64, error)                                            `perm:"read"`
-		Get               func(ctx context.Context, ids []da.ID, ns da.Namespace) ([]da.Blob, error)           `perm:"read"`
-		GetIDs            func(ctx context.Context, height uint64, ns da.Namespace) (*da.GetIDsResult, error)  `perm:"read"`
-		GetProofs         func(ctx context.Context, ids []da.ID, ns da.Namespace) ([]da.Proof, error)          `perm:"read"`
-		Commit            func(ctx context.Context, blobs []da.Blob, ns da.Namespace) ([]da.Commitment, error) `perm:"read"`
-		Validate          func(context.Context, []da.ID, []da.Proof, da.Namespace) ([]bool, error)             `perm:"read"`
-		Submit            func(context.Context, []da.Blob, float64, da.Namespace) ([]da.ID, error)             `perm:"write"`
-		SubmitWithOptions func(context.Context, []da.Blob, float64, da.Namespace, []byte) ([]da.ID, error)     `perm:"write"`
+		MaxBlobSize         func(ctx context.Context) (uint64, error)                                            `perm:"read"`
+		Get                 func(ctx context.Context, ids []da.ID, ns da.Namespace) ([]da.Blob, error)           `perm:"read"`
+		GetIDs              func(ctx context.Context, height uint64, ns da.Namespace) (*da.GetIDsResult, error)  `perm:"read"`
+		GetProofs           func(ctx context.Context, ids []da.ID, ns da.Namespace) ([]da.Proof, error)          `perm:"read"`
+		Commit              func(ctx context.Context, blobs []da.Blob, ns da.Namespace) ([]da.Commitment, error) `perm:"read"`
+		Validate            func(context.Context, []da.ID, []da.Proof, da.Namespace) ([]bool, error)             `perm:"read"`
+		Submit              func(context.Context, []da.Blob, float64, da.Namespace) ([]da.ID, error)             `perm:"write"`
+		SubmitWithOptions   func(context.Context, []da.Blob, float64, da.Namespace, []byte) ([]da.ID, error)     `perm:"write"`
+		Subscribe           func(context.Context, da.Namespace) (<-chan *da.SubscriptionEvent, error)            `perm:"read"`
+		LatestHeight        func(ctx context.Context) (uint64, error)                                            `perm:"read"`
+		GetAll              func(ctx context.Context, height uint64, ns da.Namespace) (*da.GetAllResult, error)  `perm:"read"`
+		SubmitWithReceipt   func(context.Context, []da.Blob, float64, da.Namespace, []byte) (da.Receipt, error)  `perm:"write"`
+		GetSubmissionStatus func(ctx context.Context, receipt da.Receipt) (*da.SubmissionStatus, error)          `perm:"read"`
+		GasPrice            func(ctx context.Context) (float64, error)                                           `perm:"read"`
+		GasMultiplier       func(ctx context.Context) (float64, error)                                           `perm:"read"`
+		Capabilities        func(ctx context.Context) (*da.Capabilities, error)                                  `perm:"read"`
 	}
+	// httpOnly is set for clients connected over plain HTTP, which can't receive subscription notifications
+	httpOnly bool
 }
 
 // MaxBlobSize returns the max blob size
@@ -71,6 +82,52 @@ func (api *API) SubmitWithOptions(ctx context.Context, blobs []da.Blob, gasPrice
 	return api.Internal.SubmitWithOptions(ctx, blobs, gasPrice, ns, options)
 }
 
+// Subscribe returns a channel delivering every new height.
+//
+// Subscriptions are supported only by websocket connections, clients connected over HTTP fail with
+// da.ErrNotSupported.
+func (api *API) Subscribe(ctx context.Context, ns da.Namespace) (<-chan *da.SubscriptionEvent, error) {
+	if api.httpOnly {
+		return nil, &da.ErrNotSupported{}
+	}
+	return api.Internal.Subscribe(ctx, ns)
+}
+
+// LatestHeight returns the height of the latest block available in DA.
+func (api *API) LatestHeight(ctx context.Context) (uint64, error) {
+	return api.Internal.LatestHeight(ctx)
+}
+
+// GetAll returns IDs and Blobs of all Blobs located in DA at given height.
+func (api *API) GetAll(ctx context.Context, height uint64, ns da.Namespace) (*da.GetAllResult, error) {
+	return api.Internal.GetAll(ctx, height, ns)
+}
+
+// SubmitWithReceipt submits the Blobs to Data Availability layer, without waiting for inclusion.
+func (api *API) SubmitWithReceipt(ctx context.Context, blobs []da.Blob, gasPrice float64, ns da.Namespace, options []byte) (da.Receipt, error) {
+	return api.Internal.SubmitWithReceipt(ctx, blobs, gasPrice, ns, options)
+}
+
+// GetSubmissionStatus returns the status of submission identified by given Receipt.
+func (api *API) GetSubmissionStatus(ctx context.Context, receipt da.Receipt) (*da.SubmissionStatus, error) {
+	return api.Internal.GetSubmissionStatus(ctx, receipt)
+}
+
+// GasPrice returns the gas price estimated by DA.
+func (api *API) GasPrice(ctx context.Context) (float64, error) {
+	return api.Internal.GasPrice(ctx)
+}
+
+// GasMultiplier returns the multiplier applied to gas price on resubmission.
+func (api *API) GasMultiplier(ctx context.Context) (float64, error) {
+	return api.Internal.GasMultiplier(ctx)
+}
+
+// Capabilities returns the set of features supported by DA.
+func (api *API) Capabilities(ctx context.Context) (*da.Capabilities, error) {
+	return api.Internal.Capabilities(ctx)
+}
+
 // Client is the jsonrpc client
 type Client struct {
 	DA     API
@@ -100,9 +157,12 @@ func (c *Client) Close() {
 }
 
 // NewClient creates a new Client with one connection per namespace with the
-// given token as the authorization token.
+// given token as the authorization token. If token is empty, requests are unauthenticated.
 func NewClient(ctx context.Context, addr string, token string) (*Client, error) {
-	authHeader := http.Header{"Authorization": []string{fmt.Sprintf("Bearer %s", token)}}
+	authHeader := http.Header{}
+	if token != "" {
+		authHeader.Set("Authorization", fmt.Sprintf("Bearer %s", token))
+	}
 	return newClient(ctx, addr, authHeader)
 }
 
@@ -110,13 +170,17 @@ func newClient(ctx context.Context, addr string, authHeader http.Header) (*Clien
 	var multiCloser multiClientCloser
 	var client Client
 	errs := getKnownErrorsMapping()
+	httpClient := newHTTPClient()
 	for name, module := range moduleMap(&client) {
-		closer, err := jsonrpc.NewMergeClient(ctx, addr, name, []interface{}{module}, authHeader, jsonrpc.WithErrors(errs))
+		closer, err := jsonrpc.NewMergeClient(ctx, addr, name, []interface{}{module}, authHeader,
+			jsonrpc.WithErrors(errs), jsonrpc.WithHTTPClient(httpClient))
 		if err != nil {
 			return nil, err
 		}
 		multiCloser.register(closer)
 	}
+	client.closer = multiCloser
+	client.DA.httpOnly = strings.HasPrefix(addr, "http://") || strings.HasPrefix(addr, "https://")
 
 	return &client, nil
 }
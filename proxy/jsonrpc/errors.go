@@ -17,5 +17,8 @@ func getKnownErrorsMapping() jsonrpc.Errors {
 	errs.Register(jsonrpc.ErrorCode(da.CodeTxTooLarge), new(*da.ErrTxTooLarge))
 	errs.Register(jsonrpc.ErrorCode(da.CodeContextDeadline), new(*da.ErrContextDeadline))
 	errs.Register(jsonrpc.ErrorCode(da.CodeFutureHeight), new(*da.ErrFutureHeight))
+	errs.Register(jsonrpc.ErrorCode(da.CodeReceiptNotFound), new(*da.ErrReceiptNotFound))
+	errs.Register(jsonrpc.ErrorCode(da.CodeInvalidNamespace), new(*da.ErrInvalidNamespace))
+	errs.Register(jsonrpc.ErrorCode(da.CodeNotSupported), new(*da.ErrNotSupported))
 	return errs
 }
@@ -2,10 +2,17 @@ package jsonrpc_test
 
 import (
 	"context"
+	"reflect"
 	"testing"
+	"time"
 
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 
+	"github.com/rollkit/go-da"
+	"github.com/rollkit/go-da/namespace"
+	"github.com/rollkit/go-da/proxy/auth"
 	proxy "github.com/rollkit/go-da/proxy/jsonrpc"
 	"github.com/rollkit/go-da/test"
 )
@@ -17,23 +24,176 @@ const (
 	ServerPort = "3450"
 	// ClientURL is the url to dial for the test JSONRPC client
 	ClientURL = "http://localhost:3450"
+	// WebsocketClientURL is the url to dial for the test JSONRPC client using websocket connection
+	WebsocketClientURL = "ws://localhost:3450"
 )
 
-// TestProxy runs the go-da DA test suite against the JSONRPC service
+// TestProxy runs the go-da DA test suite against the JSONRPC service, over HTTP and websocket connections
 // NOTE: This test requires a test JSONRPC service to run on the port
 // 3450 which is chosen to be sufficiently distinct from the default port
 func TestProxy(t *testing.T) {
 	dummy := test.NewDummyDA()
-	server := proxy.NewServer(ServerHost, ServerPort, dummy)
-	err := server.Start(context.Background())
+	startServer(t, dummy)
+
+	t.Run("HTTP", func(t *testing.T) {
+		client, err := proxy.NewClient(context.Background(), ClientURL, "")
+		require.NoError(t, err)
+		defer client.Close()
+		test.RunDATestSuite(t, &client.DA)
+
+		// subscriptions require websocket connection
+		_, err = client.DA.Subscribe(context.Background(), []byte{})
+		assert.ErrorIs(t, err, &da.ErrNotSupported{})
+	})
+	t.Run("Websocket", func(t *testing.T) {
+		client, err := proxy.NewClient(context.Background(), WebsocketClientURL, "")
+		require.NoError(t, err)
+		defer client.Close()
+		test.RunDATestSuite(t, &client.DA)
+	})
+}
+
+// TestProxyFallbacks ensures that GetAll and Capabilities are served for DA implementations not supporting them natively
+func TestProxyFallbacks(t *testing.T) {
+	// hide optional methods of DummyDA
+	dummy := struct{ da.DA }{test.NewDummyDA()}
+	startServer(t, dummy)
+
+	client, err := proxy.NewClient(context.Background(), WebsocketClientURL, "")
+	require.NoError(t, err)
+	defer client.Close()
+	test.GetAllTest(t, &client.DA)
+	test.CapabilitiesTest(t, &client.DA)
+
+	capabilities, err := client.DA.Capabilities(context.Background())
 	require.NoError(t, err)
-	defer func() {
-		if err := server.Stop(context.Background()); err != nil {
+	require.Empty(t, capabilities.Extensions)
+}
+
+// TestProxyNamespaceValidation ensures that malformed namespaces are rejected by the server
+func TestProxyNamespaceValidation(t *testing.T) {
+	dummy := test.NewDummyDA(test.WithNamespaceValidator(namespace.ValidateAppID))
+	startServer(t, dummy)
+
+	client, err := proxy.NewClient(context.Background(), WebsocketClientURL, "")
+	require.NoError(t, err)
+	defer client.Close()
+	test.NamespaceValidationTest(t, &client.DA, namespace.NewAppID(42), make([]byte, 200))
+}
+
+// TestProxySizeLimits ensures that size limit errors are returned by the server
+func TestProxySizeLimits(t *testing.T) {
+	dummy := test.NewDummyDA(test.WithMaxBlobSize(1024), test.WithMaxBlockSize(4096))
+	startServer(t, dummy)
+
+	client, err := proxy.NewClient(context.Background(), WebsocketClientURL, "")
+	require.NoError(t, err)
+	defer client.Close()
+	test.BlobSizeOverLimitTest(t, &client.DA)
+	test.TxTooLargeTest(t, &client.DA, 4096)
+}
+
+// TestProxyAuth ensures that permissions granted by tokens are enforced by the server
+func TestProxyAuth(t *testing.T) {
+	ctx := context.Background()
+	secret := []byte("secret")
+	startServer(t, test.NewDummyDA(), proxy.WithVerifier(auth.NewJWTVerifier(secret)), proxy.WithUnauthenticatedAccess())
+
+	newToken := func(secret []byte, perms []auth.Permission, ttl time.Duration) string {
+		token, err := auth.NewJWTToken(secret, perms, ttl)
+		require.NoError(t, err)
+		return token
+	}
+	readToken := newToken(secret, auth.ReadPerms, time.Hour)
+	writeToken := newToken(secret, auth.AllPerms, 0)
+
+	for _, url := range []string{ClientURL, WebsocketClientURL} {
+		t.Run(url, func(t *testing.T) {
+			client, err := proxy.NewClient(ctx, url, "")
+			require.NoError(t, err)
+			_, err = client.DA.MaxBlobSize(ctx)
+			assert.ErrorContains(t, err, "missing permission")
+			client.Close()
+
+			client, err = proxy.NewClient(ctx, url, readToken)
+			require.NoError(t, err)
+			_, err = client.DA.MaxBlobSize(ctx)
+			assert.NoError(t, err)
+			_, err = client.DA.Submit(ctx, []da.Blob{[]byte("blob")}, 0, nil)
+			assert.ErrorContains(t, err, "missing permission")
+			client.Close()
+
+			client, err = proxy.NewClient(ctx, url, writeToken)
 			require.NoError(t, err)
-		}
-	}()
+			_, err = client.DA.Submit(ctx, []da.Blob{[]byte("blob")}, 0, nil)
+			assert.NoError(t, err)
+			client.Close()
+		})
+	}
 
-	client, err := proxy.NewClient(context.Background(), ClientURL, "")
+	// tokens signed with other secret or expired are rejected
+	expiredToken, err := jwt.NewWithClaims(jwt.SigningMethodHS256, &auth.JWTPayload{
+		Allow:            auth.AllPerms,
+		RegisteredClaims: jwt.RegisteredClaims{ExpiresAt: jwt.NewNumericDate(time.Now().Add(-time.Minute))},
+	}).SignedString(secret)
 	require.NoError(t, err)
-	test.RunDATestSuite(t, &client.DA)
+	for _, token := range []string{newToken([]byte("other"), auth.AllPerms, 0), expiredToken} {
+		client, err := proxy.NewClient(ctx, ClientURL, token)
+		require.NoError(t, err)
+		_, err = client.DA.MaxBlobSize(ctx)
+		assert.ErrorContains(t, err, "401")
+		client.Close()
+	}
+}
+
+// TestProxyUnauthenticatedAccess ensures that permissions granted to unauthenticated requests are enforced by the server
+func TestProxyUnauthenticatedAccess(t *testing.T) {
+	ctx := context.Background()
+	startServer(t, test.NewDummyDA(), proxy.WithUnauthenticatedAccess(auth.ReadPerms...))
+
+	client, err := proxy.NewClient(ctx, ClientURL, "")
+	require.NoError(t, err)
+	defer client.Close()
+	_, err = client.DA.MaxBlobSize(ctx)
+	assert.NoError(t, err)
+	_, err = client.DA.Submit(ctx, []da.Blob{[]byte("blob")}, 0, nil)
+	assert.ErrorContains(t, err, "missing permission")
+
+	// tokens are rejected if verifier is not configured
+	client, err = proxy.NewClient(ctx, ClientURL, "token")
+	require.NoError(t, err)
+	defer client.Close()
+	_, err = client.DA.MaxBlobSize(ctx)
+	assert.ErrorContains(t, err, "401")
+}
+
+// startServer starts JSONRPC server serving given DA, and stops it after the test. Unless overridden by opts,
+// unauthenticated requests are allowed to call all methods.
+func startServer(t *testing.T, d da.DA, opts ...func(*proxy.Server) *proxy.Server) {
+	opts = append([]func(*proxy.Server) *proxy.Server{proxy.WithUnauthenticatedAccess(auth.AllPerms...)}, opts...)
+	server := proxy.NewServer(ServerHost, ServerPort, d, opts...)
+	err := server.Start(context.Background())
+	require.NoError(t, err)
+	t.Cleanup(func() {
+		require.NoError(t, server.Stop(context.Background()))
+	})
+}
+
+func TestRegisterServiceValidation(t *testing.T) {
+	server := proxy.NewServer(ServerHost, "0", test.NewDummyDA())
+	service := test.NewDummyDA()
+	assert.Error(t, server.RegisterService("other", service, nil))
+	assert.Error(t, server.RegisterService("other", service, &struct{}{}))
+	assert.Error(t, server.RegisterService("other", service, proxy.API{}))
+	assert.NoError(t, server.RegisterService("other", service, &proxy.API{}))
+}
+
+// TestAPIPerms ensures that perm tags of API, enforced by the server, match auth.MethodPerms.
+func TestAPIPerms(t *testing.T) {
+	api := reflect.TypeOf(proxy.API{}.Internal)
+	require.Equal(t, len(auth.MethodPerms), api.NumField())
+	for i := 0; i < api.NumField(); i++ {
+		field := api.Field(i)
+		assert.Equal(t, auth.MethodPerms[field.Name], auth.Permission(field.Tag.Get("perm")), field.Name)
+	}
 }
@@ -8,9 +8,11 @@ import (
 	"time"
 
 	"github.com/filecoin-project/go-jsonrpc"
+	rpcauth "github.com/filecoin-project/go-jsonrpc/auth"
 	logging "github.com/ipfs/go-log/v2"
 
 	"github.com/rollkit/go-da"
+	"github.com/rollkit/go-da/proxy/auth"
 )
 
 var log = logging.Logger("jsonrpc")
@@ -21,17 +23,30 @@ type Server struct {
 	rpc      *jsonrpc.RPCServer
 	listener net.Listener
 
+	verifier     auth.Verifier
+	defaultPerms []auth.Permission
+
 	started atomic.Bool
 }
 
 // RegisterService registers a service onto the RPC server. All methods on the service will then be
-// exposed over the RPC.
-func (s *Server) RegisterService(namespace string, service interface{}, out interface{}) {
-	s.rpc.Register(namespace, service)
+// exposed over the RPC, guarded by permissions declared in perm tags of out, which must be an API-like struct
+// with Internal field. Error is returned if out isn't a pointer to such struct.
+func (s *Server) RegisterService(namespace string, service interface{}, out interface{}) error {
+	internal, err := getInternalStruct(out)
+	if err != nil {
+		return err
+	}
+	rpcauth.PermissionedProxy(auth.AllPerms, s.defaultPerms, service, internal)
+	s.rpc.Register(namespace, out)
+	return nil
 }
 
-// NewServer accepts the host address port and the DA implementation to serve as a jsonrpc service
-func NewServer(address, port string, DA da.DA) *Server {
+// NewServer accepts the host address port and the DA implementation to serve as a jsonrpc service.
+//
+// Every call requires permission declared in API. Permissions are granted to requests by token verifier (see
+// WithVerifier); unauthenticated access has to be enabled explicitly with WithUnauthenticatedAccess.
+func NewServer(address, port string, DA da.DA, opts ...func(*Server) *Server) *Server {
 	rpc := jsonrpc.NewServer(jsonrpc.WithServerErrors(getKnownErrorsMapping()))
 	srv := &Server{
 		rpc: rpc,
@@ -41,11 +56,130 @@ func NewServer(address, port string, DA da.DA) *Server {
 			ReadHeaderTimeout: 2 * time.Second,
 		},
 	}
-	srv.srv.Handler = http.HandlerFunc(rpc.ServeHTTP)
-	srv.RegisterService("da", DA, &API{})
+	for _, opt := range opts {
+		srv = opt(srv)
+	}
+	srv.srv.Handler = tracingHandler(srv.authHandler(rpc))
+	// API is always a valid out struct
+	_ = srv.RegisterService("da", &proxySrv{target: DA}, &API{})
 	return srv
 }
 
+// proxySrv forwards calls to target DA, including methods of optional interfaces; calls of methods not implemented by
+// target fail with da.ErrNotSupported.
+//
+// GetAll and Capabilities are served even if target doesn't implement them natively. If target implements
+// da.NamespaceValidator, requests with malformed namespaces are rejected with da.ErrInvalidNamespace.
+type proxySrv struct {
+	target da.DA
+}
+
+func (p *proxySrv) MaxBlobSize(ctx context.Context) (uint64, error) {
+	return p.target.MaxBlobSize(ctx)
+}
+
+func (p *proxySrv) Get(ctx context.Context, ids []da.ID, ns da.Namespace) ([]da.Blob, error) {
+	if err := p.validateNamespace(ns); err != nil {
+		return nil, err
+	}
+	return p.target.Get(ctx, ids, ns)
+}
+
+func (p *proxySrv) GetIDs(ctx context.Context, height uint64, ns da.Namespace) (*da.GetIDsResult, error) {
+	if err := p.validateNamespace(ns); err != nil {
+		return nil, err
+	}
+	return p.target.GetIDs(ctx, height, ns)
+}
+
+func (p *proxySrv) GetProofs(ctx context.Context, ids []da.ID, ns da.Namespace) ([]da.Proof, error) {
+	if err := p.validateNamespace(ns); err != nil {
+		return nil, err
+	}
+	return p.target.GetProofs(ctx, ids, ns)
+}
+
+func (p *proxySrv) Commit(ctx context.Context, blobs []da.Blob, ns da.Namespace) ([]da.Commitment, error) {
+	if err := p.validateNamespace(ns); err != nil {
+		return nil, err
+	}
+	return p.target.Commit(ctx, blobs, ns)
+}
+
+func (p *proxySrv) Validate(ctx context.Context, ids []da.ID, proofs []da.Proof, ns da.Namespace) ([]bool, error) {
+	if err := p.validateNamespace(ns); err != nil {
+		return nil, err
+	}
+	return p.target.Validate(ctx, ids, proofs, ns)
+}
+
+func (p *proxySrv) Submit(ctx context.Context, blobs []da.Blob, gasPrice float64, ns da.Namespace) ([]da.ID, error) {
+	if err := p.validateNamespace(ns); err != nil {
+		return nil, err
+	}
+	return p.target.Submit(ctx, blobs, gasPrice, ns)
+}
+
+func (p *proxySrv) SubmitWithOptions(ctx context.Context, blobs []da.Blob, gasPrice float64, ns da.Namespace, options []byte) ([]da.ID, error) {
+	if err := p.validateNamespace(ns); err != nil {
+		return nil, err
+	}
+	return p.target.SubmitWithOptions(ctx, blobs, gasPrice, ns, options)
+}
+
+func (p *proxySrv) Subscribe(ctx context.Context, ns da.Namespace) (<-chan *da.SubscriptionEvent, error) {
+	if err := p.validateNamespace(ns); err != nil {
+		return nil, err
+	}
+	return da.Subscribe(ctx, p.target, ns)
+}
+
+func (p *proxySrv) LatestHeight(ctx context.Context) (uint64, error) {
+	return da.LatestHeight(ctx, p.target)
+}
+
+func (p *proxySrv) GetAll(ctx context.Context, height uint64, ns da.Namespace) (*da.GetAllResult, error) {
+	if err := p.validateNamespace(ns); err != nil {
+		return nil, err
+	}
+	return da.GetAll(ctx, p.target, height, ns)
+}
+
+func (p *proxySrv) SubmitWithReceipt(ctx context.Context, blobs []da.Blob, gasPrice float64, ns da.Namespace, options []byte) (da.Receipt, error) {
+	if err := p.validateNamespace(ns); err != nil {
+		return nil, err
+	}
+	return da.SubmitWithReceipt(ctx, p.target, blobs, gasPrice, ns, options)
+}
+
+func (p *proxySrv) GetSubmissionStatus(ctx context.Context, receipt da.Receipt) (*da.SubmissionStatus, error) {
+	return da.GetSubmissionStatus(ctx, p.target, receipt)
+}
+
+func (p *proxySrv) GasPrice(ctx context.Context) (float64, error) {
+	return da.GasPrice(ctx, p.target)
+}
+
+func (p *proxySrv) GasMultiplier(ctx context.Context) (float64, error) {
+	return da.GasMultiplier(ctx, p.target)
+}
+
+func (p *proxySrv) Capabilities(ctx context.Context) (*da.Capabilities, error) {
+	return da.GetCapabilities(ctx, p.target)
+}
+
+// validateNamespace returns da.ErrInvalidNamespace if target implements da.NamespaceValidator and rejects ns.
+func (p *proxySrv) validateNamespace(ns da.Namespace) error {
+	validator, ok := p.target.(da.NamespaceValidator)
+	if !ok {
+		return nil
+	}
+	if err := validator.ValidateNamespace(ns); err != nil {
+		return &da.ErrInvalidNamespace{}
+	}
+	return nil
+}
+
 // Start starts the RPC Server.
 // This function can be called multiple times concurrently
 // Once started, subsequent calls are a no-op
@@ -66,6 +200,15 @@ func (s *Server) Start(context.Context) error {
 	return nil
 }
 
+// Addr returns the address the RPC Server listens on, useful if it was started on port 0. It returns nil if the
+// server isn't started.
+func (s *Server) Addr() net.Addr {
+	if s.listener == nil {
+		return nil
+	}
+	return s.listener.Addr()
+}
+
 // Stop stops the RPC Server.
 // This function can be called multiple times concurrently
 // Once stopped, subsequent calls are a no-op
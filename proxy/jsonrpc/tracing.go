@@ -0,0 +1,38 @@
+package jsonrpc
+
+import (
+	"net/http"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/propagation"
+)
+
+// Trace context is propagated in HTTP headers, using the global OpenTelemetry propagator (see
+// otel.SetTextMapPropagator). Over websocket connections, headers are sent only once, so trace context is not
+// propagated per call.
+
+// newHTTPClient returns HTTP client used by JSON-RPC client, propagating trace context.
+func newHTTPClient() *http.Client {
+	transport := http.DefaultTransport.(*http.Transport).Clone()
+	transport.MaxIdleConnsPerHost = 100
+	return &http.Client{Transport: &tracingTransport{base: transport}}
+}
+
+// tracingTransport injects trace context from request context into request headers.
+type tracingTransport struct {
+	base http.RoundTripper
+}
+
+func (t *tracingTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	req = req.Clone(req.Context())
+	otel.GetTextMapPropagator().Inject(req.Context(), propagation.HeaderCarrier(req.Header))
+	return t.base.RoundTrip(req)
+}
+
+// tracingHandler extracts trace context from request headers into request context.
+func tracingHandler(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ctx := otel.GetTextMapPropagator().Extract(r.Context(), propagation.HeaderCarrier(r.Header))
+		next.ServeHTTP(w, r.WithContext(ctx))
+	})
+}
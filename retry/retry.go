@@ -0,0 +1,226 @@
+// Package retry provides a da.DA wrapper retrying calls failed with transient errors.
+package retry
+
+import (
+	"context"
+	"math/rand"
+	"time"
+
+	"github.com/rollkit/go-da"
+)
+
+const (
+	// DefaultMaxAttempts is the default max number of attempts of single call, including the first one.
+	DefaultMaxAttempts = 5
+	// DefaultInitialBackoff is the default delay before the first retry.
+	DefaultInitialBackoff = 100 * time.Millisecond
+	// DefaultMaxBackoff is the default max delay between retries.
+	DefaultMaxBackoff = 10 * time.Second
+	// DefaultJitter is the default fraction of backoff randomized on every retry.
+	DefaultJitter = 0.2
+)
+
+// Retrier is a da.DA wrapper retrying calls failed with errors classified as retryable (see da.IsRetryable), with
+// exponential backoff and jitter.
+//
+// Retries stop as soon as ctx is done, or if ctx deadline would be exceeded before next attempt; the last error is
+// returned in such case.
+//
+// Retrier implements da.DA only; optional interfaces are to be used on wrapped DA directly, without retries:
+// subscriptions can't be resumed without missing heights, and submissions made with receipt are tracked by the
+// caller, who can resubmit if tracking reports failure.
+type Retrier struct {
+	target         da.DA
+	maxAttempts    int
+	initialBackoff time.Duration
+	maxBackoff     time.Duration
+	jitter         float64
+	gasMultiplier  float64
+	retryable      func(error) bool
+}
+
+var _ da.DA = &Retrier{}
+
+// New creates new Retrier wrapping target DA.
+func New(target da.DA, opts ...func(*Retrier) *Retrier) *Retrier {
+	r := &Retrier{
+		target:         target,
+		maxAttempts:    DefaultMaxAttempts,
+		initialBackoff: DefaultInitialBackoff,
+		maxBackoff:     DefaultMaxBackoff,
+		jitter:         DefaultJitter,
+		retryable:      da.IsRetryable,
+	}
+	for _, opt := range opts {
+		r = opt(r)
+	}
+	return r
+}
+
+// WithMaxAttempts configures the max number of attempts of single call, including the first one.
+func WithMaxAttempts(maxAttempts int) func(*Retrier) *Retrier {
+	return func(r *Retrier) *Retrier {
+		r.maxAttempts = maxAttempts
+		return r
+	}
+}
+
+// WithBackoff configures the delay before the first retry, and the max delay between retries. Delay is doubled
+// after every retry.
+func WithBackoff(initial, maxBackoff time.Duration) func(*Retrier) *Retrier {
+	return func(r *Retrier) *Retrier {
+		r.initialBackoff = initial
+		r.maxBackoff = maxBackoff
+		return r
+	}
+}
+
+// WithJitter configures the fraction of backoff randomized on every retry, in range [0, 1].
+func WithJitter(jitter float64) func(*Retrier) *Retrier {
+	return func(r *Retrier) *Retrier {
+		r.jitter = jitter
+		return r
+	}
+}
+
+// WithGasMultiplier configures Retrier to multiply gas price by given multiplier on every resubmission of blobs.
+//
+// Negative gas price (see da.AutoGasPrice) is resolved with da.ResolveGasPrice before the first bump.
+func WithGasMultiplier(gasMultiplier float64) func(*Retrier) *Retrier {
+	return func(r *Retrier) *Retrier {
+		r.gasMultiplier = gasMultiplier
+		return r
+	}
+}
+
+// WithRetryable configures the function classifying errors as retryable. da.IsRetryable is used by default.
+func WithRetryable(retryable func(error) bool) func(*Retrier) *Retrier {
+	return func(r *Retrier) *Retrier {
+		r.retryable = retryable
+		return r
+	}
+}
+
+// MaxBlobSize returns the max blob size of wrapped DA.
+func (r *Retrier) MaxBlobSize(ctx context.Context) (maxBlobSize uint64, err error) {
+	err = r.do(ctx, func() error {
+		maxBlobSize, err = r.target.MaxBlobSize(ctx)
+		return err
+	})
+	return maxBlobSize, err
+}
+
+// Get returns Blobs for given IDs.
+func (r *Retrier) Get(ctx context.Context, ids []da.ID, ns da.Namespace) (blobs []da.Blob, err error) {
+	err = r.do(ctx, func() error {
+		blobs, err = r.target.Get(ctx, ids, ns)
+		return err
+	})
+	return blobs, err
+}
+
+// GetIDs returns IDs of Blobs at given height.
+func (r *Retrier) GetIDs(ctx context.Context, height uint64, ns da.Namespace) (ret *da.GetIDsResult, err error) {
+	err = r.do(ctx, func() error {
+		ret, err = r.target.GetIDs(ctx, height, ns)
+		return err
+	})
+	return ret, err
+}
+
+// GetProofs returns inclusion Proofs for given IDs.
+func (r *Retrier) GetProofs(ctx context.Context, ids []da.ID, ns da.Namespace) (proofs []da.Proof, err error) {
+	err = r.do(ctx, func() error {
+		proofs, err = r.target.GetProofs(ctx, ids, ns)
+		return err
+	})
+	return proofs, err
+}
+
+// Commit returns Commitments for given blobs.
+func (r *Retrier) Commit(ctx context.Context, blobs []da.Blob, ns da.Namespace) (commitments []da.Commitment, err error) {
+	err = r.do(ctx, func() error {
+		commitments, err = r.target.Commit(ctx, blobs, ns)
+		return err
+	})
+	return commitments, err
+}
+
+// Submit submits blobs, bumping gas price on every resubmission if gas multiplier is configured.
+func (r *Retrier) Submit(ctx context.Context, blobs []da.Blob, gasPrice float64, ns da.Namespace) ([]da.ID, error) {
+	return r.submit(ctx, gasPrice, func(gasPrice float64) ([]da.ID, error) {
+		return r.target.Submit(ctx, blobs, gasPrice, ns)
+	})
+}
+
+// SubmitWithOptions submits blobs, bumping gas price on every resubmission if gas multiplier is configured.
+func (r *Retrier) SubmitWithOptions(ctx context.Context, blobs []da.Blob, gasPrice float64, ns da.Namespace, options []byte) ([]da.ID, error) {
+	return r.submit(ctx, gasPrice, func(gasPrice float64) ([]da.ID, error) {
+		return r.target.SubmitWithOptions(ctx, blobs, gasPrice, ns, options)
+	})
+}
+
+// submit retries submission made by f with given gas price, bumping gas price on every resubmission if gas
+// multiplier is configured.
+func (r *Retrier) submit(ctx context.Context, gasPrice float64, f func(gasPrice float64) ([]da.ID, error)) (ids []da.ID, err error) {
+	attempt := 0
+	err = r.do(ctx, func() error {
+		if attempt > 0 && r.gasMultiplier != 0 {
+			if gasPrice, err = da.ResolveGasPrice(ctx, r.target, gasPrice); err != nil {
+				return err
+			}
+			gasPrice *= r.gasMultiplier
+		}
+		attempt++
+		ids, err = f(gasPrice)
+		return err
+	})
+	return ids, err
+}
+
+// Validate validates Proofs for given IDs.
+func (r *Retrier) Validate(ctx context.Context, ids []da.ID, proofs []da.Proof, ns da.Namespace) (results []bool, err error) {
+	err = r.do(ctx, func() error {
+		results, err = r.target.Validate(ctx, ids, proofs, ns)
+		return err
+	})
+	return results, err
+}
+
+// do calls f until it succeeds, returns non-retryable error, or retries are exhausted.
+func (r *Retrier) do(ctx context.Context, f func() error) error {
+	backoff := r.initialBackoff
+	for attempt := 1; ; attempt++ {
+		err := f()
+		if err == nil || attempt >= r.maxAttempts || !r.retryable(err) {
+			return err
+		}
+
+		delay := r.delay(backoff)
+		if deadline, ok := ctx.Deadline(); ok && time.Until(deadline) < delay {
+			return err
+		}
+		timer := time.NewTimer(delay)
+		select {
+		case <-timer.C:
+		case <-ctx.Done():
+			timer.Stop()
+			return err
+		}
+
+		backoff *= 2
+		if backoff > r.maxBackoff {
+			backoff = r.maxBackoff
+		}
+	}
+}
+
+// delay returns backoff randomized by configured jitter.
+func (r *Retrier) delay(backoff time.Duration) time.Duration {
+	if r.jitter <= 0 {
+		return backoff
+	}
+	// random factor in range [1-jitter, 1+jitter)
+	factor := 1 + r.jitter*(2*rand.Float64()-1) //nolint:gosec
+	return time.Duration(float64(backoff) * factor)
+}
@@ -0,0 +1,113 @@
+package retry_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/rollkit/go-da"
+	"github.com/rollkit/go-da/mocks"
+	"github.com/rollkit/go-da/retry"
+	"github.com/rollkit/go-da/test"
+)
+
+func TestRetrier(t *testing.T) {
+	test.RunDATestSuite(t, retry.New(test.NewDummyDA()))
+}
+
+func TestRetrierRetries(t *testing.T) {
+	ctx := context.TODO()
+	ns := da.Namespace("ns")
+	blobs := []da.Blob{[]byte("blob")}
+	ids := []da.ID{[]byte("id")}
+
+	mockDA := mocks.NewMockDA(t)
+	mockDA.EXPECT().Submit(ctx, blobs, 1.0, ns).Return(nil, &da.ErrTxTimedOut{}).Once()
+	mockDA.EXPECT().Submit(ctx, blobs, 2.0, ns).Return(nil, fmt.Errorf("wrapped: %w", &da.ErrTxIncorrectAccountSequence{})).Once()
+	mockDA.EXPECT().Submit(ctx, blobs, 4.0, ns).Return(ids, nil).Once()
+
+	r := retry.New(mockDA, retry.WithBackoff(time.Millisecond, time.Millisecond), retry.WithGasMultiplier(2))
+	ret, err := r.Submit(ctx, blobs, 1.0, ns)
+	require.NoError(t, err)
+	assert.Equal(t, ids, ret)
+
+	// SubmitWithOptions is forwarded as such, even without options
+	mockDA.EXPECT().SubmitWithOptions(ctx, blobs, 1.0, ns, []byte(nil)).Return(nil, &da.ErrTxTimedOut{}).Once()
+	mockDA.EXPECT().SubmitWithOptions(ctx, blobs, 2.0, ns, []byte(nil)).Return(ids, nil).Once()
+	ret, err = r.SubmitWithOptions(ctx, blobs, 1.0, ns, nil)
+	require.NoError(t, err)
+	assert.Equal(t, ids, ret)
+}
+
+func TestRetrierStops(t *testing.T) {
+	ctx := context.TODO()
+	ns := da.Namespace("ns")
+
+	t.Run("non-retryable error", func(t *testing.T) {
+		mockDA := mocks.NewMockDA(t)
+		mockDA.EXPECT().GetIDs(ctx, uint64(1), ns).Return(nil, &da.ErrFutureHeight{}).Once()
+
+		_, err := retry.New(mockDA).GetIDs(ctx, 1, ns)
+		assert.ErrorIs(t, err, &da.ErrFutureHeight{})
+	})
+
+	t.Run("max attempts", func(t *testing.T) {
+		mockDA := mocks.NewMockDA(t)
+		mockDA.EXPECT().MaxBlobSize(ctx).Return(0, &da.ErrContextDeadline{}).Times(3)
+
+		r := retry.New(mockDA, retry.WithMaxAttempts(3), retry.WithBackoff(time.Millisecond, time.Millisecond))
+		_, err := r.MaxBlobSize(ctx)
+		assert.ErrorIs(t, err, &da.ErrContextDeadline{})
+	})
+
+	t.Run("context deadline", func(t *testing.T) {
+		ctx, cancel := context.WithTimeout(ctx, 100*time.Millisecond)
+		defer cancel()
+
+		mockDA := mocks.NewMockDA(t)
+		mockDA.EXPECT().MaxBlobSize(ctx).Return(0, &da.ErrTxAlreadyInMempool{}).Once()
+
+		start := time.Now()
+		_, err := retry.New(mockDA, retry.WithBackoff(time.Second, time.Second)).MaxBlobSize(ctx)
+		assert.ErrorIs(t, err, &da.ErrTxAlreadyInMempool{})
+		assert.Less(t, time.Since(start), 100*time.Millisecond)
+	})
+
+	t.Run("custom classification", func(t *testing.T) {
+		mockDA := mocks.NewMockDA(t)
+		mockDA.EXPECT().MaxBlobSize(ctx).Return(0, &da.ErrTxTimedOut{}).Once()
+
+		noRetries := func(error) bool { return false }
+		_, err := retry.New(mockDA, retry.WithRetryable(noRetries)).MaxBlobSize(ctx)
+		assert.ErrorIs(t, err, &da.ErrTxTimedOut{})
+	})
+}
+
+// flakyDA fails first submissions with ErrTxTimedOut, recording gas prices of all submissions.
+type flakyDA struct {
+	*test.DummyDA
+	failures  int
+	gasPrices []float64
+}
+
+func (f *flakyDA) Submit(ctx context.Context, blobs []da.Blob, gasPrice float64, ns da.Namespace) ([]da.ID, error) {
+	f.gasPrices = append(f.gasPrices, gasPrice)
+	if len(f.gasPrices) <= f.failures {
+		return nil, &da.ErrTxTimedOut{}
+	}
+	return f.DummyDA.Submit(ctx, blobs, gasPrice, ns)
+}
+
+func TestRetrierAutoGasPrice(t *testing.T) {
+	flaky := &flakyDA{DummyDA: test.NewDummyDA(test.WithGasPrice(0.5)), failures: 2}
+
+	r := retry.New(flaky, retry.WithBackoff(time.Millisecond, time.Millisecond), retry.WithGasMultiplier(1.1))
+	ids, err := r.Submit(context.TODO(), []da.Blob{[]byte("blob")}, da.AutoGasPrice, nil)
+	require.NoError(t, err)
+	assert.Len(t, ids, 1)
+	assert.InDeltaSlice(t, []float64{da.AutoGasPrice, 0.55, 0.605}, flaky.gasPrices, 1e-9)
+}
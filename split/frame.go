@@ -0,0 +1,104 @@
+package split
+
+import (
+	"crypto/sha256"
+	"encoding/binary"
+	"errors"
+
+	"github.com/rollkit/go-da/internal/varlist"
+)
+
+// Every blob submitted by Splitter is a frame:
+//
+//	version (1 byte) | kind (1 byte) | nonce (8 bytes) | index (uvarint) | count (uvarint) | payload
+//
+// Chunk frames carry consecutive parts of the original blob; index is the position of the chunk, count is the total
+// number of chunks. Nonce identifies the original blob; it's derived from its content, so commitments are
+// deterministic.
+//
+// Manifest frames are submitted before the remaining chunks of a blob split across many submissions. Payload of
+// manifest is the list of IDs of the first index chunks, included in previous submissions, so composite ID can be
+// rebuilt from data at single height.
+
+const (
+	frameVersion = 1
+
+	kindChunk    = 0
+	kindManifest = 1
+
+	nonceSize = 8
+	// maxHeaderSize is the max size of frame header.
+	maxHeaderSize = 2 + nonceSize + 2*binary.MaxVarintLen64
+)
+
+var errMalformed = errors.New("malformed frame")
+
+type frame struct {
+	kind    byte
+	nonce   [nonceSize]byte
+	index   uint64
+	count   uint64
+	payload []byte
+}
+
+func nonceOf(blob []byte) (nonce [nonceSize]byte) {
+	hash := sha256.Sum256(blob)
+	copy(nonce[:], hash[:])
+	return nonce
+}
+
+func (f *frame) marshal() []byte {
+	buf := make([]byte, 0, maxHeaderSize+len(f.payload))
+	buf = append(buf, frameVersion, f.kind)
+	buf = append(buf, f.nonce[:]...)
+	buf = binary.AppendUvarint(buf, f.index)
+	buf = binary.AppendUvarint(buf, f.count)
+	return append(buf, f.payload...)
+}
+
+func unmarshalFrame(data []byte) (*frame, error) {
+	if len(data) < 2+nonceSize || data[0] != frameVersion || data[1] > kindManifest {
+		return nil, errMalformed
+	}
+	f := &frame{kind: data[1]}
+	copy(f.nonce[:], data[2:])
+	data = data[2+nonceSize:]
+	var n int
+	if f.index, n = binary.Uvarint(data); n <= 0 {
+		return nil, errMalformed
+	}
+	data = data[n:]
+	if f.count, n = binary.Uvarint(data); n <= 0 {
+		return nil, errMalformed
+	}
+	f.payload = data[n:]
+	if f.count == 0 || f.index > f.count || (f.kind == kindChunk && f.index == f.count) {
+		return nil, errMalformed
+	}
+	return f, nil
+}
+
+// chunk splits blob into chunk frames with payload of at most chunkSize bytes. Empty blob is a single empty chunk.
+func chunk(blob []byte, chunkSize int) [][]byte {
+	count := (len(blob) + chunkSize - 1) / chunkSize
+	if count == 0 {
+		count = 1
+	}
+	nonce := nonceOf(blob)
+	frames := make([][]byte, count)
+	for i := range frames {
+		end := min((i+1)*chunkSize, len(blob))
+		f := &frame{kind: kindChunk, nonce: nonce, index: uint64(i), count: uint64(count), payload: blob[i*chunkSize : end]}
+		frames[i] = f.marshal()
+	}
+	return frames
+}
+
+// decodeList decodes non-empty list of IDs (or proofs, commitments) encoded by varlist.Encode.
+func decodeList(data []byte) ([][]byte, error) {
+	list, err := varlist.Decode(data)
+	if err != nil || len(list) == 0 {
+		return nil, errMalformed
+	}
+	return list, nil
+}
@@ -0,0 +1,358 @@
+// Package split provides a da.DA wrapper transparently splitting blobs larger than MaxBlobSize of wrapped DA.
+package split
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/rollkit/go-da"
+	"github.com/rollkit/go-da/internal/varlist"
+)
+
+// DefaultMaxBlobSize is the default max size of blob accepted by Splitter.
+const DefaultMaxBlobSize = 16 << 20
+
+// Splitter is a da.DA wrapper splitting blobs into framed chunks fitting in MaxBlobSize of wrapped DA, and
+// reassembling them on Get.
+//
+// Every blob gets a single composite ID, encoding IDs of all its chunks; the same applies to commitments and proofs.
+// Composite IDs are also returned by GetIDs. Blobs not submitted by Splitter are ignored by GetIDs and can't be
+// retrieved with Get.
+//
+// Chunks are submitted in a single call of wrapped DA, unless max submission size is configured (see
+// WithMaxSubmissionSize). Submission spanning many calls is not atomic: if one of them fails, chunks included
+// before are orphaned.
+//
+// Optional interfaces of wrapped DA deal with chunks rather than whole blobs (e.g. GetAll would return raw frames,
+// and SubmitWithReceipt wouldn't split anything), so Splitter implements da.DA only. da.GetAll on Splitter uses
+// GetIDs and Get, returning whole blobs.
+type Splitter struct {
+	target            da.DA
+	maxBlobSize       uint64
+	maxSubmissionSize uint64
+}
+
+var _ da.DA = &Splitter{}
+
+// New creates new Splitter wrapping target DA.
+func New(target da.DA, opts ...func(*Splitter) *Splitter) *Splitter {
+	s := &Splitter{target: target, maxBlobSize: DefaultMaxBlobSize}
+	for _, opt := range opts {
+		s = opt(s)
+	}
+	return s
+}
+
+// WithMaxBlobSize configures the max size of blob accepted by Splitter, reported by MaxBlobSize.
+func WithMaxBlobSize(maxBlobSize uint64) func(*Splitter) *Splitter {
+	return func(s *Splitter) *Splitter {
+		s.maxBlobSize = maxBlobSize
+		return s
+	}
+}
+
+// WithMaxSubmissionSize configures the max total size of chunks submitted in single call of wrapped DA. Zero (the
+// default) means chunks of all blobs are submitted in single call. Max submission size must be at least twice the
+// MaxBlobSize of wrapped DA.
+func WithMaxSubmissionSize(maxSubmissionSize uint64) func(*Splitter) *Splitter {
+	return func(s *Splitter) *Splitter {
+		s.maxSubmissionSize = maxSubmissionSize
+		return s
+	}
+}
+
+// MaxBlobSize returns the max size of blob accepted by Splitter.
+func (s *Splitter) MaxBlobSize(context.Context) (uint64, error) {
+	return s.maxBlobSize, nil
+}
+
+// Get returns reassembled Blobs for given composite IDs.
+func (s *Splitter) Get(ctx context.Context, ids []da.ID, ns da.Namespace) ([]da.Blob, error) {
+	chunkIDs, err := decodeComposites(ids)
+	if err != nil {
+		return nil, err
+	}
+	chunks, err := s.target.Get(ctx, flatten(chunkIDs), ns)
+	if err != nil {
+		return nil, err
+	}
+	blobs := make([]da.Blob, len(ids))
+	for i := range chunkIDs {
+		count := len(chunkIDs[i])
+		if len(chunks) < count {
+			return nil, errors.New("unexpected number of chunks")
+		}
+		if blobs[i], err = reassemble(chunks[:count]); err != nil {
+			return nil, err
+		}
+		chunks = chunks[count:]
+	}
+	return blobs, nil
+}
+
+// GetIDs returns composite IDs of blobs whose last chunk is included at given height.
+func (s *Splitter) GetIDs(ctx context.Context, height uint64, ns da.Namespace) (*da.GetIDsResult, error) {
+	all, err := da.GetAll(ctx, s.target, height, ns)
+	if err != nil || all == nil {
+		return nil, err
+	}
+
+	type group struct {
+		count uint64
+		ids   []da.ID
+	}
+	groups := make(map[[nonceSize]byte]*group)
+	ret := &da.GetIDsResult{IDs: []da.ID{}, Timestamp: all.Timestamp}
+	for i, blob := range all.Blobs {
+		f, err := unmarshalFrame(blob)
+		if err != nil {
+			continue
+		}
+		if f.kind == kindManifest {
+			ids, err := decodeList(f.payload)
+			if err == nil && uint64(len(ids)) == f.index {
+				groups[f.nonce] = &group{count: f.count, ids: ids}
+			}
+			continue
+		}
+		g := groups[f.nonce]
+		if f.index == 0 {
+			g = &group{count: f.count}
+			groups[f.nonce] = g
+		}
+		if g == nil || g.count != f.count || uint64(len(g.ids)) != f.index {
+			delete(groups, f.nonce)
+			continue
+		}
+		g.ids = append(g.ids, all.IDs[i])
+		if uint64(len(g.ids)) == g.count {
+			ret.IDs = append(ret.IDs, varlist.Encode(g.ids))
+			delete(groups, f.nonce)
+		}
+	}
+	return ret, nil
+}
+
+// GetProofs returns composite inclusion Proofs, covering every chunk of blobs with given composite IDs.
+func (s *Splitter) GetProofs(ctx context.Context, ids []da.ID, ns da.Namespace) ([]da.Proof, error) {
+	chunkIDs, err := decodeComposites(ids)
+	if err != nil {
+		return nil, err
+	}
+	proofs, err := s.target.GetProofs(ctx, flatten(chunkIDs), ns)
+	if err != nil {
+		return nil, err
+	}
+	return compose(chunkIDs, proofs)
+}
+
+// Commit returns composite Commitments for given blobs, encoding commitments of all their chunks.
+func (s *Splitter) Commit(ctx context.Context, blobs []da.Blob, ns da.Namespace) ([]da.Commitment, error) {
+	chunks, _, err := s.chunk(ctx, blobs)
+	if err != nil {
+		return nil, err
+	}
+	commitments, err := s.target.Commit(ctx, flatten(chunks), ns)
+	if err != nil {
+		return nil, err
+	}
+	return compose(chunks, commitments)
+}
+
+// Submit splits blobs into chunks and submits them to wrapped DA, returning composite IDs.
+func (s *Splitter) Submit(ctx context.Context, blobs []da.Blob, gasPrice float64, ns da.Namespace) ([]da.ID, error) {
+	return s.submit(ctx, blobs, func(chunks []da.Blob) ([]da.ID, error) {
+		return s.target.Submit(ctx, chunks, gasPrice, ns)
+	})
+}
+
+// SubmitWithOptions splits blobs into chunks and submits them to wrapped DA, returning composite IDs.
+func (s *Splitter) SubmitWithOptions(ctx context.Context, blobs []da.Blob, gasPrice float64, ns da.Namespace, options []byte) ([]da.ID, error) {
+	return s.submit(ctx, blobs, func(chunks []da.Blob) ([]da.ID, error) {
+		return s.target.SubmitWithOptions(ctx, chunks, gasPrice, ns, options)
+	})
+}
+
+// Validate validates composite Proofs for given composite IDs. Result is true only if proofs of all chunks are valid.
+func (s *Splitter) Validate(ctx context.Context, ids []da.ID, proofs []da.Proof, ns da.Namespace) ([]bool, error) {
+	if len(ids) != len(proofs) {
+		return nil, errors.New("number of IDs doesn't match number of proofs")
+	}
+	chunkIDs, err := decodeComposites(ids)
+	if err != nil {
+		return nil, err
+	}
+	chunkProofs := make([][][]byte, len(proofs))
+	for i := range proofs {
+		if chunkProofs[i], err = decodeList(proofs[i]); err != nil || len(chunkProofs[i]) != len(chunkIDs[i]) {
+			return nil, fmt.Errorf("malformed composite proof %d", i)
+		}
+	}
+	results, err := s.target.Validate(ctx, flatten(chunkIDs), flatten(chunkProofs), ns)
+	if err != nil {
+		return nil, err
+	}
+	valid := make([]bool, len(ids))
+	for i := range chunkIDs {
+		count := len(chunkIDs[i])
+		if len(results) < count {
+			return nil, errors.New("unexpected number of validation results")
+		}
+		valid[i] = true
+		for _, ok := range results[:count] {
+			valid[i] = valid[i] && ok
+		}
+		results = results[count:]
+	}
+	return valid, nil
+}
+
+// chunk splits every blob into chunk frames fitting in MaxBlobSize of wrapped DA, which is also returned.
+func (s *Splitter) chunk(ctx context.Context, blobs []da.Blob) ([][][]byte, uint64, error) {
+	targetMaxBlobSize, err := s.target.MaxBlobSize(ctx)
+	if err != nil {
+		return nil, 0, err
+	}
+	if targetMaxBlobSize <= maxHeaderSize {
+		return nil, 0, fmt.Errorf("max blob size of wrapped DA is too small: %d", targetMaxBlobSize)
+	}
+	chunkSize := int(targetMaxBlobSize - maxHeaderSize) //nolint:gosec
+	chunks := make([][][]byte, len(blobs))
+	for i, blob := range blobs {
+		if uint64(len(blob)) > s.maxBlobSize {
+			return nil, 0, &da.ErrBlobSizeOverLimit{}
+		}
+		chunks[i] = chunk(blob, chunkSize)
+	}
+	return chunks, targetMaxBlobSize, nil
+}
+
+// submit submits chunks of blobs using given function, in as many calls as needed to respect max submission size.
+func (s *Splitter) submit(ctx context.Context, blobs []da.Blob, submit func([]da.Blob) ([]da.ID, error)) ([]da.ID, error) {
+	chunks, targetMaxBlobSize, err := s.chunk(ctx, blobs)
+	if err != nil {
+		return nil, err
+	}
+	maxSubmissionSize := s.maxSubmissionSize
+	if maxSubmissionSize == 0 {
+		maxSubmissionSize = ^uint64(0)
+	} else if maxSubmissionSize < 2*targetMaxBlobSize {
+		return nil, fmt.Errorf("max submission size %d is less than twice the max blob size of wrapped DA", maxSubmissionSize)
+	}
+
+	// chunks are submitted in order; next[b] is the index of the first chunk of blob b not added to any batch yet
+	chunkIDs := make([][]da.ID, len(blobs))
+	next := make([]int, len(blobs))
+	for b := 0; b < len(blobs); {
+		var (
+			batch  []da.Blob
+			owners []int // index of blob owning every chunk in batch, -1 for manifests
+			size   uint64
+		)
+		add := func(owner int, data []byte) {
+			batch = append(batch, data)
+			owners = append(owners, owner)
+			size += uint64(len(data))
+		}
+
+		// blob split across submissions is preceded by manifest listing IDs of chunks already submitted
+		if next[b] > 0 {
+			f := &frame{
+				kind:    kindManifest,
+				nonce:   nonceOf(blobs[b]),
+				index:   uint64(next[b]),
+				count:   uint64(len(chunks[b])),
+				payload: varlist.Encode(chunkIDs[b]),
+			}
+			manifest := f.marshal()
+			if uint64(len(manifest)) > targetMaxBlobSize {
+				return nil, fmt.Errorf("too many chunks: manifest of blob %d exceeds max blob size of wrapped DA", b)
+			}
+			add(-1, manifest)
+		}
+		for b < len(blobs) {
+			c := chunks[b][next[b]]
+			if size+uint64(len(c)) > maxSubmissionSize {
+				break
+			}
+			add(b, c)
+			if next[b]++; next[b] == len(chunks[b]) {
+				b++
+			}
+		}
+
+		ids, err := submit(batch)
+		if err != nil {
+			return nil, err
+		}
+		if len(ids) != len(batch) {
+			return nil, errors.New("unexpected number of IDs returned by wrapped DA")
+		}
+		for i, owner := range owners {
+			if owner >= 0 {
+				chunkIDs[owner] = append(chunkIDs[owner], ids[i])
+			}
+		}
+	}
+
+	ids := make([]da.ID, len(blobs))
+	for i := range chunkIDs {
+		ids[i] = varlist.Encode(chunkIDs[i])
+	}
+	return ids, nil
+}
+
+// reassemble concatenates payloads of chunk frames, verifying that they form a single blob.
+func reassemble(chunks []da.Blob) (da.Blob, error) {
+	blob := []byte{}
+	var nonce [nonceSize]byte
+	for i, data := range chunks {
+		f, err := unmarshalFrame(data)
+		if err != nil {
+			return nil, err
+		}
+		if i == 0 {
+			nonce = f.nonce
+		}
+		if f.kind != kindChunk || f.nonce != nonce || f.index != uint64(i) || f.count != uint64(len(chunks)) {
+			return nil, errMalformed
+		}
+		blob = append(blob, f.payload...)
+	}
+	return blob, nil
+}
+
+// decodeComposites decodes chunk IDs of every composite ID. Malformed IDs are reported as da.ErrBlobNotFound.
+func decodeComposites(ids []da.ID) ([][]da.ID, error) {
+	chunkIDs := make([][]da.ID, len(ids))
+	for i, id := range ids {
+		var err error
+		if chunkIDs[i], err = decodeList(id); err != nil {
+			return nil, &da.ErrBlobNotFound{}
+		}
+	}
+	return chunkIDs, nil
+}
+
+// compose groups values (proofs or commitments) of chunks into composite values, following structure of chunks.
+func compose(chunks [][][]byte, values [][]byte) ([][]byte, error) {
+	composites := make([][]byte, len(chunks))
+	for i := range chunks {
+		count := len(chunks[i])
+		if len(values) < count {
+			return nil, errors.New("unexpected number of results returned by wrapped DA")
+		}
+		composites[i] = varlist.Encode(values[:count])
+		values = values[count:]
+	}
+	return composites, nil
+}
+
+func flatten(lists [][][]byte) [][]byte {
+	var flat [][]byte
+	for _, list := range lists {
+		flat = append(flat, list...)
+	}
+	return flat
+}
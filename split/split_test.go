@@ -0,0 +1,90 @@
+package split_test
+
+import (
+	"bytes"
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/rollkit/go-da"
+	"github.com/rollkit/go-da/split"
+	"github.com/rollkit/go-da/test"
+)
+
+func TestSplitter(t *testing.T) {
+	test.RunDATestSuite(t, split.New(test.NewDummyDA(test.WithMaxBlobSize(64)), split.WithMaxBlobSize(4096)))
+}
+
+func TestSplitterRoundTrip(t *testing.T) {
+	ctx := context.TODO()
+	ns := da.Namespace("ns")
+	dummy := test.NewDummyDA(test.WithMaxBlobSize(100))
+	s := split.New(dummy)
+	blobs := []da.Blob{{}, bytes.Repeat([]byte{1}, 50), bytes.Repeat([]byte{2}, 1000), bytes.Repeat([]byte{1}, 50)}
+
+	ids, err := s.Submit(ctx, blobs, 0, ns)
+	require.NoError(t, err)
+	require.Len(t, ids, len(blobs))
+
+	ret, err := s.Get(ctx, ids, ns)
+	require.NoError(t, err)
+	assert.Equal(t, blobs, ret)
+
+	// all chunks are included at single height
+	height, err := dummy.LatestHeight(ctx)
+	require.NoError(t, err)
+	chunks, err := dummy.GetIDs(ctx, height, ns)
+	require.NoError(t, err)
+	assert.Greater(t, len(chunks.IDs), 10)
+	idsAtHeight, err := s.GetIDs(ctx, height, ns)
+	require.NoError(t, err)
+	assert.Equal(t, ids, idsAtHeight.IDs)
+
+	proofs, err := s.GetProofs(ctx, ids, ns)
+	require.NoError(t, err)
+	valid, err := s.Validate(ctx, ids, proofs, ns)
+	require.NoError(t, err)
+	assert.Equal(t, []bool{true, true, true, true}, valid)
+
+	commitments, err := s.Commit(ctx, blobs, ns)
+	require.NoError(t, err)
+	assert.Len(t, commitments, len(blobs))
+	assert.Equal(t, commitments[1], commitments[3])
+
+	// chunk IDs are not valid composite IDs
+	_, err = s.Get(ctx, chunks.IDs[:1], ns)
+	assert.ErrorIs(t, err, &da.ErrBlobNotFound{})
+}
+
+func TestSplitterMaxSubmissionSize(t *testing.T) {
+	ctx := context.TODO()
+	ns := da.Namespace("ns")
+	dummy := test.NewDummyDA(test.WithMaxBlobSize(300), test.WithMaxBlockSize(700))
+	blobs := []da.Blob{bytes.Repeat([]byte{1}, 300), bytes.Repeat([]byte{2}, 1500)}
+
+	_, err := split.New(dummy).Submit(ctx, blobs, 0, ns)
+	assert.ErrorIs(t, err, &da.ErrTxTooLarge{})
+	_, err = split.New(dummy, split.WithMaxSubmissionSize(500)).Submit(ctx, blobs, 0, ns)
+	assert.Error(t, err)
+
+	s := split.New(dummy, split.WithMaxSubmissionSize(700))
+	ids, err := s.Submit(ctx, blobs, 0, ns)
+	require.NoError(t, err)
+	ret, err := s.Get(ctx, ids, ns)
+	require.NoError(t, err)
+	assert.Equal(t, blobs, ret)
+
+	// composite IDs are returned at height of the last chunk, using manifests of blobs spanning many heights
+	latest, err := dummy.LatestHeight(ctx)
+	require.NoError(t, err)
+	assert.Greater(t, latest, uint64(3))
+	var found []da.ID
+	for height := uint64(1); height <= latest; height++ {
+		ret, err := s.GetIDs(ctx, height, ns)
+		require.NoError(t, err)
+		found = append(found, ret.IDs...)
+	}
+	assert.Equal(t, ids, found)
+}
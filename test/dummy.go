@@ -17,31 +17,89 @@ import (
 // DefaultMaxBlobSize is the default max blob size
 const DefaultMaxBlobSize = 64 * 64 * 482
 
+// DefaultMaxBlockSize is the default max total size of blobs included at single height
+const DefaultMaxBlockSize = 128 * 128 * 482
+
+// DefaultGasPrice is the default gas price estimated by DummyDA
+const DefaultGasPrice = 0.002
+
+// DefaultGasMultiplier is the default gas multiplier reported by DummyDA
+const DefaultGasMultiplier = 1.0
+
+// DefaultMempoolTTL is the default number of blocks after which submissions not included in a block are dropped
+const DefaultMempoolTTL = 5
+
+// errClosed is returned for submissions to closed DummyDA.
+var errClosed = errors.New("DA is closed")
+
 // DummyDA is a simple implementation of in-memory DA. Not production ready! Intended only for testing!
 //
-// Data is stored in a map, where key is a serialized sequence number. This key is returned as ID.
-// Commitments are simply hashes, and proofs are ED25519 signatures.
+// Data is stored in a map per namespace, where key is a serialized sequence number. This key is returned as ID.
+// Commitments are simply hashes, and proofs are ED25519 signatures over namespace and blob hashes.
+//
+// By default, every submission creates a new height. If block time is configured (see WithBlockTime), submissions
+// are collected in mempool and heights are produced at given interval instead.
 type DummyDA struct {
-	mu          *sync.Mutex // protects data and height
-	data        map[uint64][]kvp
-	timestamps  map[uint64]time.Time
-	maxBlobSize uint64
-	height      uint64
-	privKey     ed25519.PrivateKey
-	pubKey      ed25519.PublicKey
+	mu             *sync.Mutex                 // protects data, height and submissions
+	data           map[string]map[uint64][]kvp // keyed by namespace and height
+	timestamps     map[uint64]time.Time
+	maxBlobSize    uint64
+	maxBlockSize   uint64
+	height         uint64
+	newHeight      chan struct{} // closed and replaced every time height is increased
+	inclusionDelay time.Duration
+	blockTime      time.Duration
+	mempool        []*tx // pending submissions, used only if blockTime is set
+	mempoolTTL     uint64
+	stop           chan struct{} // closed when DummyDA is closed
+	closed         bool
+	gasPrice       float64
+	gasMultiplier  float64
+	validator      func(da.Namespace) error
+	submissions    map[uint64]*da.SubmissionStatus // keyed by receipt sequence number
+	lastReceipt    uint64
+	log            logFile // nil, unless DummyDA is persistent
+	privKey        ed25519.PrivateKey
+	pubKey         ed25519.PublicKey
 }
 
 type kvp struct {
 	key, value []byte
 }
 
+// tx is a submission waiting in mempool until it's included in a block.
+type tx struct {
+	blobs  []da.Blob
+	ns     da.Namespace
+	size   uint64
+	height uint64 // height at which tx was added to mempool
+	done   chan struct{}
+	ids    []da.ID
+	err    error
+	status *da.SubmissionStatus // nil, unless submitted with SubmitWithReceipt
+}
+
 // NewDummyDA create new instance of DummyDA
 func NewDummyDA(opts ...func(*DummyDA) *DummyDA) *DummyDA {
+	d := newDummyDA(opts...)
+	d.start()
+	return d
+}
+
+// newDummyDA creates new instance of DummyDA, without starting block production.
+func newDummyDA(opts ...func(*DummyDA) *DummyDA) *DummyDA {
 	da := &DummyDA{
-		mu:          new(sync.Mutex),
-		data:        make(map[uint64][]kvp),
-		timestamps:  make(map[uint64]time.Time),
-		maxBlobSize: DefaultMaxBlobSize,
+		mu:            new(sync.Mutex),
+		data:          make(map[string]map[uint64][]kvp),
+		timestamps:    make(map[uint64]time.Time),
+		maxBlobSize:   DefaultMaxBlobSize,
+		maxBlockSize:  DefaultMaxBlockSize,
+		newHeight:     make(chan struct{}),
+		submissions:   make(map[uint64]*da.SubmissionStatus),
+		gasPrice:      DefaultGasPrice,
+		gasMultiplier: DefaultGasMultiplier,
+		mempoolTTL:    DefaultMempoolTTL,
+		stop:          make(chan struct{}),
 	}
 	for _, f := range opts {
 		da = f(da)
@@ -50,7 +108,82 @@ func NewDummyDA(opts ...func(*DummyDA) *DummyDA) *DummyDA {
 	return da
 }
 
+// WithMaxBlobSize configures the max size of single blob accepted by DummyDA.
+func WithMaxBlobSize(maxBlobSize uint64) func(*DummyDA) *DummyDA {
+	return func(d *DummyDA) *DummyDA {
+		d.maxBlobSize = maxBlobSize
+		return d
+	}
+}
+
+// WithMaxBlockSize configures the max total size of blobs included at single height.
+func WithMaxBlockSize(maxBlockSize uint64) func(*DummyDA) *DummyDA {
+	return func(d *DummyDA) *DummyDA {
+		d.maxBlockSize = maxBlockSize
+		return d
+	}
+}
+
+// WithInclusionDelay configures DummyDA to include Blobs submitted with SubmitWithReceipt after given delay.
+func WithInclusionDelay(delay time.Duration) func(*DummyDA) *DummyDA {
+	return func(d *DummyDA) *DummyDA {
+		d.inclusionDelay = delay
+		return d
+	}
+}
+
+// WithBlockTime configures DummyDA to produce heights at given interval. Submissions are collected in mempool, and
+// included in next block with enough space left. Submit blocks until inclusion.
+//
+// Zero block time (default) means that every submission creates a new height immediately.
+func WithBlockTime(blockTime time.Duration) func(*DummyDA) *DummyDA {
+	return func(d *DummyDA) *DummyDA {
+		d.blockTime = blockTime
+		return d
+	}
+}
+
+// WithMempoolTTL configures the number of blocks after which submissions not included in a block are dropped from
+// mempool with ErrTxTimedOut. Zero means that submissions are never dropped. Used only if block time is set.
+func WithMempoolTTL(blocks uint64) func(*DummyDA) *DummyDA {
+	return func(d *DummyDA) *DummyDA {
+		d.mempoolTTL = blocks
+		return d
+	}
+}
+
+// WithGasPrice configures the gas price estimated by DummyDA.
+func WithGasPrice(gasPrice float64) func(*DummyDA) *DummyDA {
+	return func(d *DummyDA) *DummyDA {
+		d.gasPrice = gasPrice
+		return d
+	}
+}
+
+// WithGasMultiplier configures the gas multiplier reported by DummyDA.
+func WithGasMultiplier(gasMultiplier float64) func(*DummyDA) *DummyDA {
+	return func(d *DummyDA) *DummyDA {
+		d.gasMultiplier = gasMultiplier
+		return d
+	}
+}
+
+// WithNamespaceValidator configures DummyDA to report namespaces rejected by validator as malformed.
+func WithNamespaceValidator(validator func(da.Namespace) error) func(*DummyDA) *DummyDA {
+	return func(d *DummyDA) *DummyDA {
+		d.validator = validator
+		return d
+	}
+}
+
 var _ da.DA = &DummyDA{}
+var _ da.Subscriber = &DummyDA{}
+var _ da.LatestHeightGetter = &DummyDA{}
+var _ da.AllGetter = &DummyDA{}
+var _ da.SubmissionTracker = &DummyDA{}
+var _ da.GasEstimator = &DummyDA{}
+var _ da.CapabilitiesProvider = &DummyDA{}
+var _ da.NamespaceValidator = &DummyDA{}
 
 // MaxBlobSize returns the max blob size in bytes.
 func (d *DummyDA) MaxBlobSize(ctx context.Context) (uint64, error) {
@@ -58,9 +191,10 @@ func (d *DummyDA) MaxBlobSize(ctx context.Context) (uint64, error) {
 }
 
 // Get returns Blobs for given IDs.
-func (d *DummyDA) Get(ctx context.Context, ids []da.ID, _ da.Namespace) ([]da.Blob, error) {
+func (d *DummyDA) Get(ctx context.Context, ids []da.ID, ns da.Namespace) ([]da.Blob, error) {
 	d.mu.Lock()
 	defer d.mu.Unlock()
+	data := d.data[string(ns)]
 	blobs := make([]da.Blob, len(ids))
 	for i, id := range ids {
 		if len(id) < 8 {
@@ -68,9 +202,9 @@ func (d *DummyDA) Get(ctx context.Context, ids []da.ID, _ da.Namespace) ([]da.Bl
 		}
 		height := binary.LittleEndian.Uint64(id)
 		found := false
-		for j := 0; !found && j < len(d.data[height]); j++ {
-			if bytes.Equal(d.data[height][j].key, id) {
-				blobs[i] = d.data[height][j].value
+		for j := 0; !found && j < len(data[height]); j++ {
+			if bytes.Equal(data[height][j].key, id) {
+				blobs[i] = data[height][j].value
 				found = true
 			}
 		}
@@ -82,7 +216,7 @@ func (d *DummyDA) Get(ctx context.Context, ids []da.ID, _ da.Namespace) ([]da.Bl
 }
 
 // GetIDs returns IDs of Blobs at given DA height.
-func (d *DummyDA) GetIDs(ctx context.Context, height uint64, _ da.Namespace) (*da.GetIDsResult, error) {
+func (d *DummyDA) GetIDs(ctx context.Context, height uint64, ns da.Namespace) (*da.GetIDsResult, error) {
 	d.mu.Lock()
 	defer d.mu.Unlock()
 
@@ -90,21 +224,39 @@ func (d *DummyDA) GetIDs(ctx context.Context, height uint64, _ da.Namespace) (*d
 		return nil, &da.ErrFutureHeight{}
 	}
 
-	kvps, ok := d.data[height]
-	if !ok {
+	if _, ok := d.timestamps[height]; !ok {
 		return nil, nil
 	}
 
+	return d.getIDsResult(height, ns), nil
+}
+
+// GetAll returns IDs and Blobs at given DA height.
+func (d *DummyDA) GetAll(ctx context.Context, height uint64, ns da.Namespace) (*da.GetAllResult, error) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	if height > d.height {
+		return nil, &da.ErrFutureHeight{}
+	}
+
+	if _, ok := d.timestamps[height]; !ok {
+		return nil, nil
+	}
+
+	kvps := d.data[string(ns)][height]
 	ids := make([]da.ID, len(kvps))
+	blobs := make([]da.Blob, len(kvps))
 	for i, kv := range kvps {
 		ids[i] = kv.key
+		blobs[i] = kv.value
 	}
-	return &da.GetIDsResult{IDs: ids, Timestamp: d.timestamps[height]}, nil
+	return &da.GetAllResult{IDs: ids, Blobs: blobs, Timestamp: d.timestamps[height]}, nil
 }
 
 // GetProofs returns inclusion Proofs for all Blobs located in DA at given height.
-func (d *DummyDA) GetProofs(ctx context.Context, ids []da.ID, _ da.Namespace) ([]da.Proof, error) {
-	blobs, err := d.Get(ctx, ids, nil)
+func (d *DummyDA) GetProofs(ctx context.Context, ids []da.ID, ns da.Namespace) ([]da.Proof, error) {
+	blobs, err := d.Get(ctx, ids, ns)
 
 	d.mu.Lock()
 	defer d.mu.Unlock()
@@ -113,7 +265,7 @@ func (d *DummyDA) GetProofs(ctx context.Context, ids []da.ID, _ da.Namespace) ([
 	}
 	proofs := make([]da.Proof, len(blobs))
 	for i, blob := range blobs {
-		proofs[i] = d.getProof(ids[i], blob)
+		proofs[i] = d.getProof(ns, blob)
 	}
 	return proofs, nil
 }
@@ -133,35 +285,370 @@ func (d *DummyDA) Submit(ctx context.Context, blobs []da.Blob, gasPrice float64,
 }
 
 // SubmitWithOptions stores blobs in DA layer (options are ignored).
-func (d *DummyDA) SubmitWithOptions(ctx context.Context, blobs []da.Blob, gasPrice float64, _ da.Namespace, _ []byte) ([]da.ID, error) {
+//
+// ErrBlobSizeOverLimit is returned if any blob exceeds max blob size, and ErrTxTooLarge if total size of blobs
+// exceeds max block size.
+//
+// If block time is set, SubmitWithOptions waits until blobs are included in a block. ErrContextDeadline is returned
+// if ctx deadline is exceeded first, and ErrTxTimedOut if blobs are dropped from mempool.
+func (d *DummyDA) SubmitWithOptions(ctx context.Context, blobs []da.Blob, gasPrice float64, ns da.Namespace, _ []byte) ([]da.ID, error) {
+	if err := d.checkSize(blobs); err != nil {
+		return nil, err
+	}
+
+	d.mu.Lock()
+	if d.closed {
+		d.mu.Unlock()
+		return nil, errClosed
+	}
+	if d.blockTime == 0 {
+		defer d.mu.Unlock()
+		return d.submit(blobs, ns)
+	}
+	t := d.addTx(blobs, ns, nil)
+	d.mu.Unlock()
+
+	select {
+	case <-t.done:
+		return t.ids, t.err
+	case <-ctx.Done():
+	}
+
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	select {
+	case <-t.done:
+		return t.ids, t.err
+	default:
+	}
+	d.removeTx(t)
+	if errors.Is(ctx.Err(), context.DeadlineExceeded) {
+		return nil, &da.ErrContextDeadline{}
+	}
+	return nil, ctx.Err()
+}
+
+// SubmitWithReceipt stores blobs in DA layer after configured inclusion delay (options are ignored).
+//
+// If block time is set, blobs are added to mempool and included in a block instead; inclusion delay is not used.
+func (d *DummyDA) SubmitWithReceipt(ctx context.Context, blobs []da.Blob, gasPrice float64, ns da.Namespace, _ []byte) (da.Receipt, error) {
+	if err := d.checkSize(blobs); err != nil {
+		return nil, err
+	}
+
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	if d.closed {
+		return nil, errClosed
+	}
+
+	d.lastReceipt++
+	receipt := d.getID(d.lastReceipt)
+	status := &da.SubmissionStatus{State: da.SubmissionStatePending}
+	d.submissions[d.lastReceipt] = status
+
+	blobs = append([]da.Blob(nil), blobs...)
+	ns = append(da.Namespace(nil), ns...)
+	if d.blockTime != 0 {
+		d.addTx(blobs, ns, status)
+		return receipt, nil
+	}
+
+	include := func() {
+		ids, err := d.submit(blobs, ns)
+		if err != nil {
+			status.State = da.SubmissionStateFailed
+			status.Error = err.Error()
+			return
+		}
+		status.IDs = ids
+		status.Height = d.height
+		status.State = da.SubmissionStateIncluded
+	}
+	if d.inclusionDelay == 0 {
+		include()
+	} else {
+		time.AfterFunc(d.inclusionDelay, func() {
+			d.mu.Lock()
+			defer d.mu.Unlock()
+			include()
+		})
+	}
+
+	return receipt, nil
+}
+
+// GetSubmissionStatus returns the status of submission identified by given Receipt.
+func (d *DummyDA) GetSubmissionStatus(ctx context.Context, receipt da.Receipt) (*da.SubmissionStatus, error) {
+	if len(receipt) != 8 {
+		return nil, &da.ErrReceiptNotFound{}
+	}
+
 	d.mu.Lock()
 	defer d.mu.Unlock()
+	status, ok := d.submissions[binary.LittleEndian.Uint64(receipt)]
+	if !ok {
+		return nil, &da.ErrReceiptNotFound{}
+	}
+	ret := *status
+	return &ret, nil
+}
+
+// checkSize ensures that blobs fit in size limits.
+func (d *DummyDA) checkSize(blobs []da.Blob) error {
+	var total uint64
+	for _, blob := range blobs {
+		if uint64(len(blob)) > d.maxBlobSize {
+			return &da.ErrBlobSizeOverLimit{}
+		}
+		total += uint64(len(blob))
+	}
+	if total > d.maxBlockSize {
+		return &da.ErrTxTooLarge{}
+	}
+	return nil
+}
+
+// submit stores blobs in given namespace at new height. Caller must hold d.mu.
+func (d *DummyDA) submit(blobs []da.Blob, ns da.Namespace) ([]da.ID, error) {
+	b := &block{Height: d.height + 1, Timestamp: time.Now()}
+	ids := d.appendBlobs(b, blobs, ns)
+
+	if err := d.persist(b); err != nil {
+		return nil, err
+	}
+	d.addBlock(b)
+
+	return ids, nil
+}
+
+// appendBlobs adds blobs in given namespace to block and returns their IDs.
+func (d *DummyDA) appendBlobs(b *block, blobs []da.Blob, ns da.Namespace) []da.ID {
 	ids := make([]da.ID, len(blobs))
-	d.height += 1
-	d.timestamps[d.height] = time.Now()
 	for i, blob := range blobs {
-		ids[i] = append(d.nextID(), d.getHash(blob)...)
+		ids[i] = append(d.getID(b.Height), d.getHash(blob)...)
+		b.Blobs = append(b.Blobs, blockBlob{Namespace: ns, ID: ids[i], Data: blob})
+	}
+	return ids
+}
 
-		d.data[d.height] = append(d.data[d.height], kvp{ids[i], blob})
+// addTx adds blobs to mempool. Caller must hold d.mu.
+func (d *DummyDA) addTx(blobs []da.Blob, ns da.Namespace, status *da.SubmissionStatus) *tx {
+	t := &tx{blobs: blobs, ns: ns, height: d.height, done: make(chan struct{}), status: status}
+	for _, blob := range blobs {
+		t.size += uint64(len(blob))
 	}
+	d.mempool = append(d.mempool, t)
+	return t
+}
 
-	return ids, nil
+// removeTx removes tx from mempool. Caller must hold d.mu.
+func (d *DummyDA) removeTx(t *tx) {
+	for i := range d.mempool {
+		if d.mempool[i] == t {
+			d.mempool = append(d.mempool[:i], d.mempool[i+1:]...)
+			return
+		}
+	}
+}
+
+// finishTx sets the result of tx and notifies the submitter. Caller must hold d.mu.
+func (d *DummyDA) finishTx(t *tx, height uint64, ids []da.ID, err error) {
+	t.ids, t.err = ids, err
+	if t.status != nil {
+		if err != nil {
+			t.status.State = da.SubmissionStateFailed
+			t.status.Error = err.Error()
+		} else {
+			t.status.State = da.SubmissionStateIncluded
+			t.status.Height = height
+			t.status.IDs = ids
+		}
+	}
+	close(t.done)
+}
+
+// start starts block production, if block time is set.
+func (d *DummyDA) start() {
+	if d.blockTime == 0 {
+		return
+	}
+	go func() {
+		ticker := time.NewTicker(d.blockTime)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-ticker.C:
+				d.mu.Lock()
+				d.produceBlock()
+				d.mu.Unlock()
+			case <-d.stop:
+				return
+			}
+		}
+	}()
+}
+
+// produceBlock creates a new height, including submissions from mempool in order, as long as they fit in max block
+// size. Submissions waiting longer than mempool TTL are dropped. Caller must hold d.mu.
+func (d *DummyDA) produceBlock() {
+	b := &block{Height: d.height + 1, Timestamp: time.Now()}
+	var included, pending []*tx
+	var size uint64
+	for _, t := range d.mempool {
+		if size+t.size <= d.maxBlockSize {
+			size += t.size
+			t.ids = d.appendBlobs(b, t.blobs, t.ns)
+			included = append(included, t)
+		} else if d.mempoolTTL != 0 && b.Height-t.height >= d.mempoolTTL {
+			d.finishTx(t, 0, nil, &da.ErrTxTimedOut{})
+		} else {
+			pending = append(pending, t)
+		}
+	}
+
+	if err := d.persist(b); err != nil {
+		for _, t := range included {
+			d.finishTx(t, 0, nil, err)
+		}
+		d.mempool = pending
+		return
+	}
+	d.addBlock(b)
+	for _, t := range included {
+		d.finishTx(t, b.Height, t.ids, nil)
+	}
+	d.mempool = pending
+}
+
+// Close stops block production and releases resources used by DummyDA. Submissions pending in mempool fail.
+func (d *DummyDA) Close() error {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	if d.closed {
+		return nil
+	}
+	d.closed = true
+	close(d.stop)
+	for _, t := range d.mempool {
+		d.finishTx(t, 0, nil, errClosed)
+	}
+	d.mempool = nil
+
+	if d.log == nil {
+		return nil
+	}
+	err := d.log.Close()
+	d.log = nil
+	return err
+}
+
+// addBlock stores block in memory and notifies subscribers. Caller must hold d.mu.
+func (d *DummyDA) addBlock(b *block) {
+	d.height = b.Height
+	d.timestamps[b.Height] = b.Timestamp
+	for _, blob := range b.Blobs {
+		data, ok := d.data[string(blob.Namespace)]
+		if !ok {
+			data = make(map[uint64][]kvp)
+			d.data[string(blob.Namespace)] = data
+		}
+		data[b.Height] = append(data[b.Height], kvp{blob.ID, blob.Data})
+	}
+	close(d.newHeight)
+	d.newHeight = make(chan struct{})
 }
 
 // Validate checks the Proofs for given IDs.
-func (d *DummyDA) Validate(ctx context.Context, ids []da.ID, proofs []da.Proof, _ da.Namespace) ([]bool, error) {
+func (d *DummyDA) Validate(ctx context.Context, ids []da.ID, proofs []da.Proof, ns da.Namespace) ([]bool, error) {
 	if len(ids) != len(proofs) {
 		return nil, errors.New("number of IDs doesn't equal to number of proofs")
 	}
 	results := make([]bool, len(ids))
 	for i := 0; i < len(ids); i++ {
-		results[i] = ed25519.Verify(d.pubKey, ids[i][8:], proofs[i])
+		results[i] = len(ids[i]) > 8 && ed25519.Verify(d.pubKey, d.getProofMessage(ns, ids[i][8:]), proofs[i])
 	}
 	return results, nil
 }
 
-func (d *DummyDA) nextID() []byte {
-	return d.getID(d.height)
+// Subscribe returns a channel delivering every new height produced by DummyDA, with IDs of Blobs in given namespace.
+func (d *DummyDA) Subscribe(ctx context.Context, ns da.Namespace) (<-chan *da.SubscriptionEvent, error) {
+	d.mu.Lock()
+	next := d.height + 1
+	d.mu.Unlock()
+
+	events := make(chan *da.SubscriptionEvent)
+	go func() {
+		defer close(events)
+		for {
+			d.mu.Lock()
+			if next > d.height {
+				newHeight := d.newHeight
+				d.mu.Unlock()
+				select {
+				case <-newHeight:
+					continue
+				case <-ctx.Done():
+					return
+				}
+			}
+			event := &da.SubscriptionEvent{Height: next, GetIDsResult: *d.getIDsResult(next, ns)}
+			d.mu.Unlock()
+
+			select {
+			case events <- event:
+				next++
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+	return events, nil
+}
+
+// Capabilities returns the set of features supported by DummyDA.
+func (d *DummyDA) Capabilities(ctx context.Context) (*da.Capabilities, error) {
+	return &da.Capabilities{
+		ProofType:   "ed25519",
+		MaxBlobSize: d.maxBlobSize,
+		BlockTime:   d.blockTime,
+		Extensions:  da.SupportedExtensions(d),
+	}, nil
+}
+
+// ValidateNamespace checks namespace with configured validator. All namespaces are valid by default.
+func (d *DummyDA) ValidateNamespace(ns da.Namespace) error {
+	if d.validator == nil {
+		return nil
+	}
+	return d.validator(ns)
+}
+
+// GasPrice returns the configured gas price.
+func (d *DummyDA) GasPrice(ctx context.Context) (float64, error) {
+	return d.gasPrice, nil
+}
+
+// GasMultiplier returns the configured gas multiplier.
+func (d *DummyDA) GasMultiplier(ctx context.Context) (float64, error) {
+	return d.gasMultiplier, nil
+}
+
+// LatestHeight returns the height of the latest block created by DummyDA.
+func (d *DummyDA) LatestHeight(ctx context.Context) (uint64, error) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	return d.height, nil
+}
+
+// getIDsResult returns IDs of Blobs in given namespace at given height. Caller must hold d.mu.
+func (d *DummyDA) getIDsResult(height uint64, ns da.Namespace) *da.GetIDsResult {
+	kvps := d.data[string(ns)][height]
+	ids := make([]da.ID, len(kvps))
+	for i, kv := range kvps {
+		ids[i] = kv.key
+	}
+	return &da.GetIDsResult{IDs: ids, Timestamp: d.timestamps[height]}
 }
 
 func (d *DummyDA) getID(cnt uint64) []byte {
@@ -175,7 +662,12 @@ func (d *DummyDA) getHash(blob []byte) []byte {
 	return sha[:]
 }
 
-func (d *DummyDA) getProof(id, blob []byte) []byte {
-	sign, _ := d.privKey.Sign(rand.Reader, d.getHash(blob), &ed25519.Options{})
+func (d *DummyDA) getProof(ns, blob []byte) []byte {
+	sign, _ := d.privKey.Sign(rand.Reader, d.getProofMessage(ns, d.getHash(blob)), &ed25519.Options{})
 	return sign
 }
+
+// getProofMessage returns the message signed by proofs, binding blob hash to namespace.
+func (d *DummyDA) getProofMessage(ns, blobHash []byte) []byte {
+	return append(d.getHash(ns), blobHash...)
+}
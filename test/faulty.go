@@ -0,0 +1,255 @@
+package test
+
+import (
+	"context"
+	"errors"
+	"math/rand"
+	"sync"
+	"time"
+
+	"github.com/rollkit/go-da"
+)
+
+// DefaultFaultErrors is the set of errors injected by FaultyDA, unless Fault.Errors is set.
+var DefaultFaultErrors = []error{
+	&da.ErrTxTimedOut{},
+	&da.ErrTxAlreadyInMempool{},
+	&da.ErrTxIncorrectAccountSequence{},
+	&da.ErrContextDeadline{},
+}
+
+// daMethods are names of methods of da.DA.
+var daMethods = []string{"MaxBlobSize", "Get", "GetIDs", "GetProofs", "Commit", "Submit", "SubmitWithOptions", "Validate"}
+
+// Fault describes faults injected by FaultyDA into calls of a method. Rates are probabilities in range [0, 1].
+type Fault struct {
+	// Latency is added to every call.
+	Latency time.Duration
+	// LatencyJitter is the max random latency added to every call, on top of Latency.
+	LatencyJitter time.Duration
+	// ErrorRate is the probability of failing the call (without calling wrapped DA) with one of Errors.
+	ErrorRate float64
+	// Errors is the set of errors returned by failed calls. DefaultFaultErrors are used if empty.
+	Errors []error
+	// DropRate is the probability of silently dropping submitted blobs. Dropped submissions return random IDs,
+	// unknown to wrapped DA. Used by Submit and SubmitWithOptions.
+	DropRate float64
+	// CorruptRate is the probability of corrupting each returned blob. Used by Get.
+	CorruptRate float64
+	// InvalidProofRate is the probability of corrupting each returned proof (in GetProofs), or reporting each proof as
+	// invalid (in Validate).
+	InvalidProofRate float64
+}
+
+// FaultyDA is a da.DA decorator injecting configurable faults, for testing resilience to DA misbehavior.
+//
+// Faults are driven by random number generator created from given seed, so sequential calls are reproducible.
+// Faults can be injected into methods of da.DA only, so optional interfaces of wrapped DA are hidden rather than
+// served without faults: code under test sees FaultyDA as a DA without extensions.
+type FaultyDA struct {
+	target da.DA
+	faults map[string]Fault // keyed by method name
+
+	mu  sync.Mutex // protects rng
+	rng *rand.Rand
+}
+
+var _ da.DA = &FaultyDA{}
+
+// NewFaultyDA creates new FaultyDA wrapping target DA.
+func NewFaultyDA(target da.DA, seed int64, opts ...func(*FaultyDA) *FaultyDA) *FaultyDA {
+	f := &FaultyDA{
+		target: target,
+		faults: make(map[string]Fault),
+		rng:    rand.New(rand.NewSource(seed)), //nolint:gosec
+	}
+	for _, opt := range opts {
+		f = opt(f)
+	}
+	return f
+}
+
+// WithFault configures FaultyDA to inject fault into calls of given methods (for example "Submit"), or all methods
+// if none are given.
+func WithFault(fault Fault, methods ...string) func(*FaultyDA) *FaultyDA {
+	return func(f *FaultyDA) *FaultyDA {
+		if len(methods) == 0 {
+			methods = daMethods
+		}
+		for _, method := range methods {
+			f.faults[method] = fault
+		}
+		return f
+	}
+}
+
+// MaxBlobSize returns the max blob size of wrapped DA.
+func (f *FaultyDA) MaxBlobSize(ctx context.Context) (uint64, error) {
+	if err := f.inject(ctx, "MaxBlobSize"); err != nil {
+		return 0, err
+	}
+	return f.target.MaxBlobSize(ctx)
+}
+
+// Get returns Blobs for given IDs, possibly corrupted.
+func (f *FaultyDA) Get(ctx context.Context, ids []da.ID, ns da.Namespace) ([]da.Blob, error) {
+	if err := f.inject(ctx, "Get"); err != nil {
+		return nil, err
+	}
+	blobs, err := f.target.Get(ctx, ids, ns)
+	if err != nil {
+		return nil, err
+	}
+	rate := f.faults["Get"].CorruptRate
+	for i := range blobs {
+		if f.chance(rate) {
+			blobs[i] = f.corrupt(blobs[i])
+		}
+	}
+	return blobs, nil
+}
+
+// GetIDs returns IDs of Blobs at given height.
+func (f *FaultyDA) GetIDs(ctx context.Context, height uint64, ns da.Namespace) (*da.GetIDsResult, error) {
+	if err := f.inject(ctx, "GetIDs"); err != nil {
+		return nil, err
+	}
+	return f.target.GetIDs(ctx, height, ns)
+}
+
+// GetProofs returns inclusion Proofs for given IDs, possibly corrupted.
+func (f *FaultyDA) GetProofs(ctx context.Context, ids []da.ID, ns da.Namespace) ([]da.Proof, error) {
+	if err := f.inject(ctx, "GetProofs"); err != nil {
+		return nil, err
+	}
+	proofs, err := f.target.GetProofs(ctx, ids, ns)
+	if err != nil {
+		return nil, err
+	}
+	rate := f.faults["GetProofs"].InvalidProofRate
+	for i := range proofs {
+		if f.chance(rate) {
+			proofs[i] = f.corrupt(proofs[i])
+		}
+	}
+	return proofs, nil
+}
+
+// Commit returns Commitments for given blobs.
+func (f *FaultyDA) Commit(ctx context.Context, blobs []da.Blob, ns da.Namespace) ([]da.Commitment, error) {
+	if err := f.inject(ctx, "Commit"); err != nil {
+		return nil, err
+	}
+	return f.target.Commit(ctx, blobs, ns)
+}
+
+// Submit submits blobs to wrapped DA, unless they are dropped.
+func (f *FaultyDA) Submit(ctx context.Context, blobs []da.Blob, gasPrice float64, ns da.Namespace) ([]da.ID, error) {
+	if err := f.inject(ctx, "Submit"); err != nil {
+		return nil, err
+	}
+	if f.chance(f.faults["Submit"].DropRate) {
+		return f.randomIDs(len(blobs)), nil
+	}
+	return f.target.Submit(ctx, blobs, gasPrice, ns)
+}
+
+// SubmitWithOptions submits blobs to wrapped DA, unless they are dropped.
+func (f *FaultyDA) SubmitWithOptions(ctx context.Context, blobs []da.Blob, gasPrice float64, ns da.Namespace, options []byte) ([]da.ID, error) {
+	if err := f.inject(ctx, "SubmitWithOptions"); err != nil {
+		return nil, err
+	}
+	if f.chance(f.faults["SubmitWithOptions"].DropRate) {
+		return f.randomIDs(len(blobs)), nil
+	}
+	return f.target.SubmitWithOptions(ctx, blobs, gasPrice, ns, options)
+}
+
+// Validate validates Proofs for given IDs, possibly reporting valid proofs as invalid.
+func (f *FaultyDA) Validate(ctx context.Context, ids []da.ID, proofs []da.Proof, ns da.Namespace) ([]bool, error) {
+	if err := f.inject(ctx, "Validate"); err != nil {
+		return nil, err
+	}
+	results, err := f.target.Validate(ctx, ids, proofs, ns)
+	if err != nil {
+		return nil, err
+	}
+	rate := f.faults["Validate"].InvalidProofRate
+	for i := range results {
+		if f.chance(rate) {
+			results[i] = false
+		}
+	}
+	return results, nil
+}
+
+// inject applies latency and random error configured for method.
+func (f *FaultyDA) inject(ctx context.Context, method string) error {
+	fault, ok := f.faults[method]
+	if !ok {
+		return nil
+	}
+
+	f.mu.Lock()
+	latency := fault.Latency
+	if fault.LatencyJitter > 0 {
+		latency += time.Duration(f.rng.Int63n(int64(fault.LatencyJitter)))
+	}
+	var err error
+	if fault.ErrorRate > 0 && f.rng.Float64() < fault.ErrorRate {
+		errs := fault.Errors
+		if len(errs) == 0 {
+			errs = DefaultFaultErrors
+		}
+		err = errs[f.rng.Intn(len(errs))]
+	}
+	f.mu.Unlock()
+
+	if latency > 0 {
+		timer := time.NewTimer(latency)
+		defer timer.Stop()
+		select {
+		case <-timer.C:
+		case <-ctx.Done():
+			if errors.Is(ctx.Err(), context.DeadlineExceeded) {
+				return &da.ErrContextDeadline{}
+			}
+			return ctx.Err()
+		}
+	}
+	return err
+}
+
+// chance returns true with given probability.
+func (f *FaultyDA) chance(rate float64) bool {
+	if rate <= 0 {
+		return false
+	}
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return f.rng.Float64() < rate
+}
+
+// corrupt returns a copy of b with one random byte flipped (or a single random byte, if b is empty).
+func (f *FaultyDA) corrupt(b []byte) []byte {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	if len(b) == 0 {
+		return []byte{byte(f.rng.Intn(256))}
+	}
+	corrupted := append([]byte(nil), b...)
+	corrupted[f.rng.Intn(len(corrupted))] ^= 0xff
+	return corrupted
+}
+
+// randomIDs returns n random IDs, shaped like IDs of DummyDA.
+func (f *FaultyDA) randomIDs(n int) []da.ID {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	ids := make([]da.ID, n)
+	for i := range ids {
+		ids[i] = make([]byte, 8+32)
+		_, _ = f.rng.Read(ids[i])
+	}
+	return ids
+}
@@ -0,0 +1,149 @@
+package test
+
+import (
+	"bufio"
+	"crypto/ed25519"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/rollkit/go-da"
+)
+
+const (
+	keyFileName = "key"
+	logFileName = "blocks.log"
+)
+
+// block is a single height of DummyDA. Persistent DummyDA stores every block as a line of JSON in the log file.
+type block struct {
+	Height    uint64
+	Timestamp time.Time
+	Blobs     []blockBlob
+}
+
+type blockBlob struct {
+	Namespace da.Namespace
+	ID        da.ID
+	Data      da.Blob
+}
+
+// logFile is the log file of persistent DummyDA, opened for reading and writing at its end.
+type logFile interface {
+	io.WriteSeeker
+	io.Closer
+	Truncate(size int64) error
+}
+
+// NewPersistentDummyDA creates new instance of DummyDA, persisting its data in given directory.
+//
+// Heights, timestamps, blobs and the signing key are restored if directory contains data of previous instance.
+// Submission receipts are kept in memory only. Close must be called to release the log file.
+func NewPersistentDummyDA(dir string, opts ...func(*DummyDA) *DummyDA) (*DummyDA, error) {
+	if err := os.MkdirAll(dir, 0o700); err != nil {
+		return nil, err
+	}
+
+	d := newDummyDA(opts...)
+	if err := d.loadKey(filepath.Join(dir, keyFileName)); err != nil {
+		return nil, err
+	}
+	if err := d.loadLog(filepath.Join(dir, logFileName)); err != nil {
+		return nil, err
+	}
+	d.start()
+	return d, nil
+}
+
+// loadKey restores the signing key from given file, or saves the generated one if file doesn't exist.
+func (d *DummyDA) loadKey(path string) error {
+	key, err := os.ReadFile(path) //nolint:gosec
+	if errors.Is(err, os.ErrNotExist) {
+		return os.WriteFile(path, d.privKey, 0o600)
+	}
+	if err != nil {
+		return err
+	}
+	if len(key) != ed25519.PrivateKeySize {
+		return fmt.Errorf("invalid key file %s: expected %d bytes, got %d", path, ed25519.PrivateKeySize, len(key))
+	}
+	d.privKey = key
+	d.pubKey = d.privKey.Public().(ed25519.PublicKey)
+	return nil
+}
+
+// loadLog replays blocks from given log file and opens it for appending new blocks.
+//
+// Incomplete last line (left by interrupted write) is discarded.
+func (d *DummyDA) loadLog(path string) error {
+	f, err := os.OpenFile(path, os.O_RDWR|os.O_CREATE, 0o600) //nolint:gosec
+	if err != nil {
+		return err
+	}
+
+	var offset int64
+	r := bufio.NewReader(f)
+	for {
+		line, err := r.ReadBytes('\n')
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			_ = f.Close()
+			return err
+		}
+
+		var b block
+		if err := json.Unmarshal(line, &b); err != nil {
+			_ = f.Close()
+			return fmt.Errorf("invalid block in %s at offset %d: %w", path, offset, err)
+		}
+		if b.Height != d.height+1 {
+			_ = f.Close()
+			return fmt.Errorf("invalid block in %s at offset %d: expected height %d, got %d", path, offset, d.height+1, b.Height)
+		}
+		d.addBlock(&b)
+		offset += int64(len(line))
+	}
+
+	if err := f.Truncate(offset); err != nil {
+		_ = f.Close()
+		return err
+	}
+	if _, err := f.Seek(offset, io.SeekStart); err != nil {
+		_ = f.Close()
+		return err
+	}
+	d.log = f
+	return nil
+}
+
+// persist appends block to the log file, if DummyDA is persistent. Caller must hold d.mu.
+func (d *DummyDA) persist(b *block) error {
+	if d.log == nil {
+		return nil
+	}
+	line, err := json.Marshal(b)
+	if err != nil {
+		return err
+	}
+	offset, err := d.log.Seek(0, io.SeekCurrent)
+	if err != nil {
+		return err
+	}
+	_, err = d.log.Write(append(line, '\n'))
+	if err != nil {
+		// partially written line must be discarded, otherwise blocks appended after it would break the log
+		if truncErr := d.log.Truncate(offset); truncErr != nil {
+			return errors.Join(err, truncErr)
+		}
+		if _, seekErr := d.log.Seek(offset, io.SeekStart); seekErr != nil {
+			return errors.Join(err, seekErr)
+		}
+	}
+	return err
+}
@@ -0,0 +1,63 @@
+package test
+
+import (
+	"context"
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/rollkit/go-da"
+)
+
+// tornLog writes only half of the first line written after failNext is set, and fails.
+type tornLog struct {
+	logFile
+	failNext bool
+}
+
+func (l *tornLog) Write(p []byte) (int, error) {
+	if !l.failNext {
+		return l.logFile.Write(p)
+	}
+	l.failNext = false
+	n, _ := l.logFile.Write(p[:len(p)/2])
+	return n, errors.New("disk full")
+}
+
+func TestPersistentDummyDAFailedWrite(t *testing.T) {
+	ctx := context.TODO()
+	dir := t.TempDir()
+
+	dummy, err := NewPersistentDummyDA(dir)
+	require.NoError(t, err)
+	_, err = dummy.Submit(ctx, []da.Blob{[]byte("first")}, 0, nil)
+	require.NoError(t, err)
+
+	path := filepath.Join(dir, logFileName)
+	before, err := os.Stat(path)
+	require.NoError(t, err)
+	dummy.log = &tornLog{logFile: dummy.log, failNext: true}
+	_, err = dummy.Submit(ctx, []da.Blob{[]byte("failed")}, 0, nil)
+	assert.Error(t, err)
+	after, err := os.Stat(path)
+	require.NoError(t, err)
+	assert.Equal(t, before.Size(), after.Size())
+	ids, err := dummy.Submit(ctx, []da.Blob{[]byte("second")}, 0, nil)
+	require.NoError(t, err)
+	require.NoError(t, dummy.Close())
+
+	reopened, err := NewPersistentDummyDA(dir)
+	require.NoError(t, err)
+	defer func() { require.NoError(t, reopened.Close()) }()
+
+	latest, err := reopened.LatestHeight(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, uint64(2), latest)
+	blobs, err := reopened.Get(ctx, ids, nil)
+	require.NoError(t, err)
+	assert.Equal(t, []da.Blob{[]byte("second")}, blobs)
+}
@@ -3,6 +3,7 @@ package test
 import (
 	"bytes"
 	"context"
+	"errors"
 	"sync"
 	"testing"
 	"time"
@@ -22,15 +23,48 @@ func RunDATestSuite(t *testing.T, d da.DA) {
 	t.Run("Get IDs and all data", func(t *testing.T) {
 		GetIDsTest(t, d)
 	})
+	t.Run("Get all data at height", func(t *testing.T) {
+		GetAllTest(t, d)
+	})
+	t.Run("Namespace isolation", func(t *testing.T) {
+		NamespaceIsolationTest(t, d)
+	})
 	t.Run("Check Errors", func(t *testing.T) {
 		CheckErrors(t, d)
 	})
+	t.Run("Blob size over limit", func(t *testing.T) {
+		BlobSizeOverLimitTest(t, d)
+	})
 	t.Run("Concurrent read/write test", func(t *testing.T) {
 		ConcurrentReadWriteTest(t, d)
 	})
 	t.Run("Given height is from the future", func(t *testing.T) {
 		HeightFromFutureTest(t, d)
 	})
+	t.Run("Capabilities", func(t *testing.T) {
+		CapabilitiesTest(t, d)
+	})
+	t.Run("Latest height", func(t *testing.T) {
+		LatestHeightTest(t, d)
+	})
+	t.Run("Submission status", func(t *testing.T) {
+		SubmissionStatusTest(t, d)
+	})
+	t.Run("Gas price estimation", func(t *testing.T) {
+		GasEstimatorTest(t, d)
+	})
+	t.Run("Subscribe to new heights", func(t *testing.T) {
+		SubscribeTest(t, d)
+	})
+}
+
+// skipIfNotSupported skips the test if err reports that d doesn't implement the optional interface under test. It
+// covers both DA not implementing the interface and wrappers or proxies forwarding calls to such DA.
+func skipIfNotSupported(t *testing.T, err error) {
+	var notSupported *da.ErrNotSupported
+	if errors.As(err, &notSupported) {
+		t.Skip("DA doesn't support", err)
+	}
 }
 
 // BasicDATest tests round trip of messages to DA and back.
@@ -87,6 +121,37 @@ func CheckErrors(t *testing.T, d da.DA) {
 	assert.Empty(t, blob)
 }
 
+// BlobSizeOverLimitTest ensures that blobs larger than MaxBlobSize are rejected with ErrBlobSizeOverLimit.
+func BlobSizeOverLimitTest(t *testing.T, d da.DA) {
+	ctx := context.TODO()
+	maxBlobSize, err := d.MaxBlobSize(ctx)
+	assert.NoError(t, err)
+
+	ids, err := d.Submit(ctx, []da.Blob{make([]byte, maxBlobSize+1)}, 0, testNamespace)
+	assert.ErrorIs(t, err, &da.ErrBlobSizeOverLimit{})
+	assert.Empty(t, ids)
+}
+
+// TxTooLargeTest ensures that batches of blobs larger than maxTxSize in total are rejected with ErrTxTooLarge, even
+// if every blob fits in MaxBlobSize.
+func TxTooLargeTest(t *testing.T, d da.DA, maxTxSize uint64) {
+	ctx := context.TODO()
+	maxBlobSize, err := d.MaxBlobSize(ctx)
+	assert.NoError(t, err)
+
+	var blobs []da.Blob
+	for total := uint64(0); total <= maxTxSize; total += maxBlobSize {
+		blobs = append(blobs, make([]byte, maxBlobSize))
+	}
+	ids, err := d.Submit(ctx, blobs, 0, testNamespace)
+	assert.ErrorIs(t, err, &da.ErrTxTooLarge{})
+	assert.Empty(t, ids)
+
+	ids, err = d.Submit(ctx, []da.Blob{make([]byte, maxBlobSize)}, 0, testNamespace)
+	assert.NoError(t, err)
+	assert.Len(t, ids, 1)
+}
+
 // GetIDsTest tests iteration over DA
 func GetIDsTest(t *testing.T, d da.DA) {
 	msgs := [][]byte{[]byte("msg1"), []byte("msg2"), []byte("msg3")}
@@ -103,7 +168,7 @@ func GetIDsTest(t *testing.T, d da.DA) {
 	// As we're the only user, we don't need to handle external data (that could be submitted in real world).
 	// There is no notion of height, so we need to scan the DA to get test data back.
 	for i := uint64(1); !found && !time.Now().After(end); i++ {
-		ret, err := d.GetIDs(ctx, i, []byte{})
+		ret, err := d.GetIDs(ctx, i, testNamespace)
 		if err != nil {
 			t.Error("failed to get IDs:", err)
 		}
@@ -129,6 +194,128 @@ func GetIDsTest(t *testing.T, d da.DA) {
 	assert.True(t, found)
 }
 
+// GetAllTest tests that GetAll returns the same data as GetIDs followed by Get.
+func GetAllTest(t *testing.T, d da.DA) {
+	msgs := [][]byte{[]byte("all1"), []byte("all2")}
+
+	ctx := context.TODO()
+	ids, err := d.Submit(ctx, msgs, 0, testNamespace)
+	assert.NoError(t, err)
+	assert.Len(t, ids, len(msgs))
+
+	found := false
+	for i := uint64(1); !found; i++ {
+		ret, err := d.GetIDs(ctx, i, testNamespace)
+		if err != nil {
+			assert.ErrorIs(t, err, &da.ErrFutureHeight{})
+			break
+		}
+		if ret == nil || len(ret.IDs) == 0 || !bytes.Equal(ret.IDs[0], ids[0]) {
+			continue
+		}
+		found = true
+
+		all, err := da.GetAll(ctx, d, i, testNamespace)
+		assert.NoError(t, err)
+		assert.NotNil(t, all)
+		assert.Equal(t, ret.IDs, all.IDs)
+		assert.Equal(t, msgs, all.Blobs)
+		assert.True(t, ret.Timestamp.Equal(all.Timestamp))
+
+		_, err = da.GetAll(ctx, d, 999999999, testNamespace)
+		assert.ErrorIs(t, err, &da.ErrFutureHeight{})
+	}
+
+	assert.True(t, found)
+}
+
+// NamespaceIsolationTest ensures that Blobs submitted to one namespace are not visible in another one.
+func NamespaceIsolationTest(t *testing.T, d da.DA) {
+	ns1 := da.Namespace("isolated1")
+	ns2 := da.Namespace("isolated2")
+	msg1 := []byte("isolated message 1")
+	msg2 := []byte("isolated message 2")
+
+	ctx := context.TODO()
+	ids1, err := d.Submit(ctx, []da.Blob{msg1}, 0, ns1)
+	assert.NoError(t, err)
+	assert.Len(t, ids1, 1)
+	ids2, err := d.Submit(ctx, []da.Blob{msg2}, 0, ns2)
+	assert.NoError(t, err)
+	assert.Len(t, ids2, 1)
+
+	blobs, err := d.Get(ctx, ids1, ns1)
+	assert.NoError(t, err)
+	assert.Equal(t, []da.Blob{msg1}, blobs)
+
+	_, err = d.Get(ctx, ids1, ns2)
+	assert.ErrorIs(t, err, &da.ErrBlobNotFound{})
+	_, err = d.Get(ctx, ids2, ns1)
+	assert.ErrorIs(t, err, &da.ErrBlobNotFound{})
+
+	_, err = d.GetProofs(ctx, ids1, ns2)
+	assert.Error(t, err)
+
+	proofs, err := d.GetProofs(ctx, ids1, ns1)
+	assert.NoError(t, err)
+	oks, err := d.Validate(ctx, ids1, proofs, ns1)
+	assert.NoError(t, err)
+	assert.Equal(t, []bool{true}, oks)
+	oks, err = d.Validate(ctx, ids1, proofs, ns2)
+	assert.NoError(t, err)
+	assert.Equal(t, []bool{false}, oks)
+
+	// every height must list only IDs from requested namespace
+	found := false
+	for i := uint64(1); ; i++ {
+		ret, err := d.GetIDs(ctx, i, ns1)
+		if err != nil {
+			assert.ErrorIs(t, err, &da.ErrFutureHeight{})
+			break
+		}
+		if ret == nil {
+			continue
+		}
+		assert.NotContains(t, ret.IDs, ids2[0])
+		for _, id := range ret.IDs {
+			if bytes.Equal(id, ids1[0]) {
+				found = true
+			}
+		}
+
+		ret, err = d.GetIDs(ctx, i, ns2)
+		assert.NoError(t, err)
+		if ret != nil {
+			assert.NotContains(t, ret.IDs, ids1[0])
+		}
+	}
+	assert.True(t, found)
+}
+
+// NamespaceValidationTest ensures that requests with malformed namespace are rejected with ErrInvalidNamespace, while
+// requests with valid namespace succeed.
+func NamespaceValidationTest(t *testing.T, d da.DA, valid, invalid da.Namespace) {
+	ctx := context.TODO()
+	msg := []byte("namespaced message")
+
+	ids, err := d.Submit(ctx, []da.Blob{msg}, 0, valid)
+	assert.NoError(t, err)
+	assert.Len(t, ids, 1)
+
+	blobs, err := d.Get(ctx, ids, valid)
+	assert.NoError(t, err)
+	assert.Equal(t, []da.Blob{msg}, blobs)
+
+	_, err = d.Submit(ctx, []da.Blob{msg}, 0, invalid)
+	assert.ErrorIs(t, err, &da.ErrInvalidNamespace{})
+
+	_, err = d.Get(ctx, ids, invalid)
+	assert.ErrorIs(t, err, &da.ErrInvalidNamespace{})
+
+	_, err = d.GetIDs(ctx, 1, invalid)
+	assert.ErrorIs(t, err, &da.ErrInvalidNamespace{})
+}
+
 // ConcurrentReadWriteTest tests the use of mutex lock in DummyDA by calling separate methods that use `d.data` and making sure there's no race conditions
 func ConcurrentReadWriteTest(t *testing.T, d da.DA) {
 	var wg sync.WaitGroup
@@ -165,3 +352,165 @@ func HeightFromFutureTest(t *testing.T, d da.DA) {
 	assert.ErrorIs(t, err, &da.ErrFutureHeight{})
 	assert.Nil(t, ret)
 }
+
+// CapabilitiesTest tests that capabilities reported by DA are consistent with other calls.
+func CapabilitiesTest(t *testing.T, d da.DA) {
+	ctx := context.TODO()
+	capabilities, err := da.GetCapabilities(ctx, d)
+	assert.NoError(t, err)
+	if !assert.NotNil(t, capabilities) {
+		return
+	}
+
+	maxBlobSize, err := d.MaxBlobSize(ctx)
+	assert.NoError(t, err)
+	assert.Equal(t, maxBlobSize, capabilities.MaxBlobSize)
+
+	// reported extensions must be usable through d
+	supported := da.SupportedExtensions(d)
+	for _, extension := range capabilities.Extensions {
+		assert.Contains(t, supported, extension)
+	}
+}
+
+// LatestHeightTest tests that latest height follows submissions and bounds GetIDs.
+func LatestHeightTest(t *testing.T, d da.DA) {
+	ctx := context.TODO()
+	before, err := da.LatestHeight(ctx, d)
+	skipIfNotSupported(t, err)
+	assert.NoError(t, err)
+
+	ids, err := d.Submit(ctx, []da.Blob{[]byte("latest height message")}, 0, testNamespace)
+	assert.NoError(t, err)
+	assert.Len(t, ids, 1)
+
+	after, err := da.LatestHeight(ctx, d)
+	assert.NoError(t, err)
+	assert.Greater(t, after, before)
+
+	ret, err := d.GetIDs(ctx, after, testNamespace)
+	assert.NoError(t, err)
+	assert.NotNil(t, ret)
+
+	// DA may produce new heights in the meantime, so height following the latest one is checked only if latest
+	// height didn't change during the query
+	end := time.Now().Add(5 * time.Second)
+	for !time.Now().After(end) {
+		latest, err := da.LatestHeight(ctx, d)
+		assert.NoError(t, err)
+		ret, err = d.GetIDs(ctx, latest+1, testNamespace)
+		if current, _ := da.LatestHeight(ctx, d); current != latest {
+			continue
+		}
+		assert.ErrorIs(t, err, &da.ErrFutureHeight{})
+		assert.Nil(t, ret)
+		return
+	}
+	t.Error("latest height changed during every query of the following height")
+}
+
+// SubmissionStatusTest tests that submissions made with receipt are eventually included.
+func SubmissionStatusTest(t *testing.T, d da.DA) {
+	msg := []byte("message with receipt")
+	ctx := context.TODO()
+	receipt, err := da.SubmitWithReceipt(ctx, d, []da.Blob{msg}, 0, testNamespace, nil)
+	skipIfNotSupported(t, err)
+	assert.NoError(t, err)
+	assert.NotEmpty(t, receipt)
+
+	var status *da.SubmissionStatus
+	end := time.Now().Add(5 * time.Second)
+	for !time.Now().After(end) {
+		status, err = da.GetSubmissionStatus(ctx, d, receipt)
+		assert.NoError(t, err)
+		if status == nil || status.State != da.SubmissionStatePending {
+			break
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+
+	if assert.NotNil(t, status) && assert.Equal(t, da.SubmissionStateIncluded, status.State) {
+		assert.NotZero(t, status.Height)
+		assert.Len(t, status.IDs, 1)
+
+		blobs, err := d.Get(ctx, status.IDs, testNamespace)
+		assert.NoError(t, err)
+		assert.Equal(t, []da.Blob{msg}, blobs)
+
+		ret, err := d.GetIDs(ctx, status.Height, testNamespace)
+		assert.NoError(t, err)
+		assert.Contains(t, ret.IDs, status.IDs[0])
+	}
+
+	status, err = da.GetSubmissionStatus(ctx, d, []byte("invalid receipt"))
+	assert.ErrorIs(t, err, &da.ErrReceiptNotFound{})
+	assert.Nil(t, status)
+}
+
+// GasEstimatorTest tests gas price estimation and submission with estimated gas price.
+func GasEstimatorTest(t *testing.T, d da.DA) {
+	ctx := context.TODO()
+	gasPrice, err := da.GasPrice(ctx, d)
+	skipIfNotSupported(t, err)
+	assert.NoError(t, err)
+	assert.GreaterOrEqual(t, gasPrice, float64(0))
+
+	gasMultiplier, err := da.GasMultiplier(ctx, d)
+	assert.NoError(t, err)
+	assert.Positive(t, gasMultiplier)
+
+	resolved, err := da.ResolveGasPrice(ctx, d, da.AutoGasPrice)
+	assert.NoError(t, err)
+	assert.Equal(t, gasPrice, resolved)
+
+	ids, err := d.Submit(ctx, []da.Blob{[]byte("estimated gas price")}, da.AutoGasPrice, testNamespace)
+	assert.NoError(t, err)
+	assert.Len(t, ids, 1)
+}
+
+// SubscribeTest tests that heights created after subscription are delivered to subscriber.
+func SubscribeTest(t *testing.T, d da.DA) {
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	events, err := da.Subscribe(ctx, d, testNamespace)
+	skipIfNotSupported(t, err)
+	if !assert.NoError(t, err) {
+		return
+	}
+
+	ids, err := d.Submit(ctx, []da.Blob{[]byte("subscribed message")}, 0, testNamespace)
+	assert.NoError(t, err)
+	assert.Len(t, ids, 1)
+
+	var lastHeight uint64
+	for found := false; !found; {
+		select {
+		case event, ok := <-events:
+			if !ok {
+				t.Fatal("subscription closed before receiving submitted blob")
+			}
+			if lastHeight != 0 {
+				assert.Equal(t, lastHeight+1, event.Height)
+			}
+			lastHeight = event.Height
+			assert.NotZero(t, event.Timestamp)
+			for _, id := range event.IDs {
+				if bytes.Equal(id, ids[0]) {
+					found = true
+				}
+			}
+		case <-ctx.Done():
+			t.Fatal("timed out waiting for subscribed height")
+		}
+	}
+
+	ret, err := d.GetIDs(ctx, lastHeight, testNamespace)
+	assert.NoError(t, err)
+	assert.Contains(t, ret.IDs, ids[0])
+
+	cancel()
+	for range events {
+		// drain channel, it should be closed after context is cancelled
+	}
+}
@@ -0,0 +1,247 @@
+// Package tracing provides a da.DA wrapper creating OpenTelemetry spans for DA calls.
+//
+// The wrapper can be used on client side (wrapping proxy client) and on server side (wrapping DA served by proxy).
+// Both proxies propagate trace context from client to server, using the global OpenTelemetry propagator (see
+// otel.SetTextMapPropagator), so server spans become children of client spans.
+package tracing
+
+import (
+	"context"
+	"encoding/hex"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
+
+	"github.com/rollkit/go-da"
+)
+
+// TracerName is the name of tracer used by Traced.
+const TracerName = "github.com/rollkit/go-da/tracing"
+
+// Span attribute keys.
+const (
+	AttrNamespace = attribute.Key("da.namespace")
+	AttrBlobCount = attribute.Key("da.blob.count")
+	AttrBlobSize  = attribute.Key("da.blob.size")
+	AttrIDCount   = attribute.Key("da.id.count")
+	AttrHeight    = attribute.Key("da.height")
+	AttrGasPrice  = attribute.Key("da.gas_price")
+	AttrErrorCode = attribute.Key("da.error.code")
+)
+
+// Traced is a da.DA wrapper creating a span for every call.
+//
+// Like metrics.Instrumented, Traced exposes every optional interface: methods not implemented by wrapped DA fail with
+// da.ErrNotSupported, and da.SupportedExtensions reports extensions of wrapped DA (see da.Unwrapper). GetAll and
+// Capabilities fall back to da.GetAll and da.GetCapabilities.
+type Traced struct {
+	target da.DA
+	tracer trace.Tracer
+}
+
+var _ da.DA = &Traced{}
+var _ da.Subscriber = &Traced{}
+var _ da.LatestHeightGetter = &Traced{}
+var _ da.AllGetter = &Traced{}
+var _ da.SubmissionTracker = &Traced{}
+var _ da.GasEstimator = &Traced{}
+var _ da.CapabilitiesProvider = &Traced{}
+var _ da.NamespaceValidator = &Traced{}
+var _ da.Unwrapper = &Traced{}
+
+// Wrap creates new Traced wrapping target DA. If provider is nil, the global tracer provider is used.
+func Wrap(target da.DA, provider trace.TracerProvider) *Traced {
+	if provider == nil {
+		provider = otel.GetTracerProvider()
+	}
+	return &Traced{target: target, tracer: provider.Tracer(TracerName)}
+}
+
+// Unwrap returns the wrapped DA.
+func (t *Traced) Unwrap() da.DA {
+	return t.target
+}
+
+// MaxBlobSize returns the max blob size of wrapped DA.
+func (t *Traced) MaxBlobSize(ctx context.Context) (maxBlobSize uint64, err error) {
+	ctx, span := t.start(ctx, "MaxBlobSize")
+	defer func() { end(span, err) }()
+	return t.target.MaxBlobSize(ctx)
+}
+
+// Get returns Blobs for given IDs.
+func (t *Traced) Get(ctx context.Context, ids []da.ID, ns da.Namespace) (blobs []da.Blob, err error) {
+	ctx, span := t.start(ctx, "Get", namespace(ns), AttrIDCount.Int(len(ids)))
+	defer func() { end(span, err) }()
+	blobs, err = t.target.Get(ctx, ids, ns)
+	span.SetAttributes(blobAttrs(blobs)...)
+	return blobs, err
+}
+
+// GetIDs returns IDs of Blobs at given height.
+func (t *Traced) GetIDs(ctx context.Context, height uint64, ns da.Namespace) (ret *da.GetIDsResult, err error) {
+	ctx, span := t.start(ctx, "GetIDs", namespace(ns), height64(height))
+	defer func() { end(span, err) }()
+	ret, err = t.target.GetIDs(ctx, height, ns)
+	if ret != nil {
+		span.SetAttributes(AttrIDCount.Int(len(ret.IDs)))
+	}
+	return ret, err
+}
+
+// GetProofs returns inclusion Proofs for given IDs.
+func (t *Traced) GetProofs(ctx context.Context, ids []da.ID, ns da.Namespace) (proofs []da.Proof, err error) {
+	ctx, span := t.start(ctx, "GetProofs", namespace(ns), AttrIDCount.Int(len(ids)))
+	defer func() { end(span, err) }()
+	return t.target.GetProofs(ctx, ids, ns)
+}
+
+// Commit returns Commitments for given blobs.
+func (t *Traced) Commit(ctx context.Context, blobs []da.Blob, ns da.Namespace) (commitments []da.Commitment, err error) {
+	ctx, span := t.start(ctx, "Commit", append(blobAttrs(blobs), namespace(ns))...)
+	defer func() { end(span, err) }()
+	return t.target.Commit(ctx, blobs, ns)
+}
+
+// Submit submits blobs to wrapped DA.
+func (t *Traced) Submit(ctx context.Context, blobs []da.Blob, gasPrice float64, ns da.Namespace) (ids []da.ID, err error) {
+	ctx, span := t.start(ctx, "Submit", append(blobAttrs(blobs), namespace(ns), AttrGasPrice.Float64(gasPrice))...)
+	defer func() { end(span, err) }()
+	ids, err = t.target.Submit(ctx, blobs, gasPrice, ns)
+	span.SetAttributes(idAttrs(ids)...)
+	return ids, err
+}
+
+// SubmitWithOptions submits blobs to wrapped DA.
+func (t *Traced) SubmitWithOptions(ctx context.Context, blobs []da.Blob, gasPrice float64, ns da.Namespace, options []byte) (ids []da.ID, err error) {
+	ctx, span := t.start(ctx, "SubmitWithOptions", append(blobAttrs(blobs), namespace(ns), AttrGasPrice.Float64(gasPrice))...)
+	defer func() { end(span, err) }()
+	ids, err = t.target.SubmitWithOptions(ctx, blobs, gasPrice, ns, options)
+	span.SetAttributes(idAttrs(ids)...)
+	return ids, err
+}
+
+// Validate validates Proofs for given IDs.
+func (t *Traced) Validate(ctx context.Context, ids []da.ID, proofs []da.Proof, ns da.Namespace) (results []bool, err error) {
+	ctx, span := t.start(ctx, "Validate", namespace(ns), AttrIDCount.Int(len(ids)))
+	defer func() { end(span, err) }()
+	return t.target.Validate(ctx, ids, proofs, ns)
+}
+
+// Subscribe subscribes to new heights of wrapped DA. The span covers only establishing the subscription.
+func (t *Traced) Subscribe(ctx context.Context, ns da.Namespace) (events <-chan *da.SubscriptionEvent, err error) {
+	spanCtx, span := t.start(ctx, "Subscribe", namespace(ns))
+	defer func() { end(span, err) }()
+	// subscription outlives the span, so it uses the original context, with span context attached
+	return da.Subscribe(trace.ContextWithSpanContext(ctx, trace.SpanContextFromContext(spanCtx)), t.target, ns)
+}
+
+// LatestHeight returns the latest height of wrapped DA.
+func (t *Traced) LatestHeight(ctx context.Context) (height uint64, err error) {
+	ctx, span := t.start(ctx, "LatestHeight")
+	defer func() { end(span, err) }()
+	height, err = da.LatestHeight(ctx, t.target)
+	span.SetAttributes(height64(height))
+	return height, err
+}
+
+// GetAll returns IDs and Blobs at given height.
+func (t *Traced) GetAll(ctx context.Context, height uint64, ns da.Namespace) (ret *da.GetAllResult, err error) {
+	ctx, span := t.start(ctx, "GetAll", namespace(ns), height64(height))
+	defer func() { end(span, err) }()
+	ret, err = da.GetAll(ctx, t.target, height, ns)
+	if ret != nil {
+		span.SetAttributes(blobAttrs(ret.Blobs)...)
+	}
+	return ret, err
+}
+
+// SubmitWithReceipt submits blobs to wrapped DA asynchronously.
+func (t *Traced) SubmitWithReceipt(ctx context.Context, blobs []da.Blob, gasPrice float64, ns da.Namespace, options []byte) (receipt da.Receipt, err error) {
+	ctx, span := t.start(ctx, "SubmitWithReceipt", append(blobAttrs(blobs), namespace(ns), AttrGasPrice.Float64(gasPrice))...)
+	defer func() { end(span, err) }()
+	return da.SubmitWithReceipt(ctx, t.target, blobs, gasPrice, ns, options)
+}
+
+// GetSubmissionStatus returns the status of submission identified by given Receipt.
+func (t *Traced) GetSubmissionStatus(ctx context.Context, receipt da.Receipt) (status *da.SubmissionStatus, err error) {
+	ctx, span := t.start(ctx, "GetSubmissionStatus")
+	defer func() { end(span, err) }()
+	status, err = da.GetSubmissionStatus(ctx, t.target, receipt)
+	if status != nil && status.State == da.SubmissionStateIncluded {
+		span.SetAttributes(height64(status.Height), AttrIDCount.Int(len(status.IDs)))
+	}
+	return status, err
+}
+
+// GasPrice returns the gas price estimated by wrapped DA.
+func (t *Traced) GasPrice(ctx context.Context) (gasPrice float64, err error) {
+	ctx, span := t.start(ctx, "GasPrice")
+	defer func() { end(span, err) }()
+	gasPrice, err = da.GasPrice(ctx, t.target)
+	span.SetAttributes(AttrGasPrice.Float64(gasPrice))
+	return gasPrice, err
+}
+
+// GasMultiplier returns the gas multiplier of wrapped DA.
+func (t *Traced) GasMultiplier(ctx context.Context) (gasMultiplier float64, err error) {
+	ctx, span := t.start(ctx, "GasMultiplier")
+	defer func() { end(span, err) }()
+	return da.GasMultiplier(ctx, t.target)
+}
+
+// Capabilities returns the set of features supported by wrapped DA.
+func (t *Traced) Capabilities(ctx context.Context) (capabilities *da.Capabilities, err error) {
+	ctx, span := t.start(ctx, "Capabilities")
+	defer func() { end(span, err) }()
+	return da.GetCapabilities(ctx, t.target)
+}
+
+// ValidateNamespace checks namespace with wrapped DA. All namespaces are valid if it isn't a da.NamespaceValidator.
+func (t *Traced) ValidateNamespace(ns da.Namespace) error {
+	if validator, ok := t.target.(da.NamespaceValidator); ok {
+		return validator.ValidateNamespace(ns)
+	}
+	return nil
+}
+
+func (t *Traced) start(ctx context.Context, method string, attrs ...attribute.KeyValue) (context.Context, trace.Span) {
+	return t.tracer.Start(ctx, "da."+method, trace.WithAttributes(attrs...))
+}
+
+// end records err (if any) and ends the span.
+func end(span trace.Span, err error) {
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+		if code := da.CodeOf(err); code != 0 {
+			span.SetAttributes(AttrErrorCode.Int(int(code)))
+		}
+	}
+	span.End()
+}
+
+func namespace(ns da.Namespace) attribute.KeyValue {
+	return AttrNamespace.String(hex.EncodeToString(ns))
+}
+
+func height64(height uint64) attribute.KeyValue {
+	return AttrHeight.Int64(int64(height)) //nolint:gosec
+}
+
+func blobAttrs(blobs []da.Blob) []attribute.KeyValue {
+	size := 0
+	for _, blob := range blobs {
+		size += len(blob)
+	}
+	return []attribute.KeyValue{AttrBlobCount.Int(len(blobs)), AttrBlobSize.Int(size)}
+}
+
+func idAttrs(ids []da.ID) []attribute.KeyValue {
+	if ids == nil {
+		return nil
+	}
+	return []attribute.KeyValue{AttrIDCount.Int(len(ids))}
+}
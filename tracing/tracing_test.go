@@ -0,0 +1,174 @@
+package tracing_test
+
+import (
+	"context"
+	"encoding/hex"
+	"net"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/propagation"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+
+	"github.com/rollkit/go-da"
+	"github.com/rollkit/go-da/proxy/auth"
+	proxygrpc "github.com/rollkit/go-da/proxy/grpc"
+	proxyjsonrpc "github.com/rollkit/go-da/proxy/jsonrpc"
+	"github.com/rollkit/go-da/test"
+	"github.com/rollkit/go-da/tracing"
+)
+
+func TestTraced(t *testing.T) {
+	test.RunDATestSuite(t, tracing.Wrap(test.NewDummyDA(), nil))
+}
+
+func TestTracedSpans(t *testing.T) {
+	ctx := context.TODO()
+	recorder := tracetest.NewSpanRecorder()
+	provider := sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(recorder))
+	ns := da.Namespace("ns")
+
+	d := tracing.Wrap(test.NewDummyDA(test.WithMaxBlobSize(10)), provider)
+	ids, err := d.Submit(ctx, []da.Blob{[]byte("first"), []byte("second")}, 0.5, ns)
+	require.NoError(t, err)
+	_, err = d.Submit(ctx, []da.Blob{make([]byte, 11)}, 0, ns)
+	assert.ErrorIs(t, err, &da.ErrBlobSizeOverLimit{})
+	_, err = d.Get(ctx, ids, ns)
+	require.NoError(t, err)
+
+	spans := recorder.Ended()
+	require.Len(t, spans, 3)
+	for _, span := range spans {
+		assert.Equal(t, tracing.TracerName, span.InstrumentationScope().Name)
+	}
+
+	assert.Equal(t, "da.Submit", spans[0].Name())
+	assert.Equal(t, codes.Unset, spans[0].Status().Code)
+	assertAttrs(t, spans[0], map[attribute.Key]attribute.Value{
+		tracing.AttrNamespace: attribute.StringValue(hex.EncodeToString(ns)),
+		tracing.AttrBlobCount: attribute.IntValue(2),
+		tracing.AttrBlobSize:  attribute.IntValue(11),
+		tracing.AttrGasPrice:  attribute.Float64Value(0.5),
+		tracing.AttrIDCount:   attribute.IntValue(2),
+	})
+
+	assert.Equal(t, "da.Submit", spans[1].Name())
+	assert.Equal(t, codes.Error, spans[1].Status().Code)
+	require.Len(t, spans[1].Events(), 1)
+	assert.Equal(t, "exception", spans[1].Events()[0].Name)
+	assertAttrs(t, spans[1], map[attribute.Key]attribute.Value{
+		tracing.AttrErrorCode: attribute.IntValue(int(da.CodeBlobSizeOverLimit)),
+	})
+
+	assert.Equal(t, "da.Get", spans[2].Name())
+	assertAttrs(t, spans[2], map[attribute.Key]attribute.Value{
+		tracing.AttrIDCount:   attribute.IntValue(2),
+		tracing.AttrBlobCount: attribute.IntValue(2),
+		tracing.AttrBlobSize:  attribute.IntValue(11),
+	})
+}
+
+func TestTracedUnsupported(t *testing.T) {
+	ctx := context.TODO()
+	recorder := tracetest.NewSpanRecorder()
+	provider := sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(recorder))
+	d := tracing.Wrap(struct{ da.DA }{test.NewDummyDA()}, provider)
+
+	_, err := d.LatestHeight(ctx)
+	assert.ErrorIs(t, err, &da.ErrNotSupported{})
+
+	capabilities, err := d.Capabilities(ctx)
+	require.NoError(t, err)
+	assert.Empty(t, capabilities.Extensions)
+	assert.Empty(t, da.SupportedExtensions(d))
+
+	spans := recorder.Ended()
+	require.Len(t, spans, 2)
+	assert.Equal(t, codes.Error, spans[0].Status().Code)
+	assert.Contains(t, spans[0].Attributes(), tracing.AttrErrorCode.Int(int(da.CodeNotSupported)))
+	assert.Equal(t, codes.Unset, spans[1].Status().Code)
+
+	test.RunDATestSuite(t, d)
+}
+
+func TestPropagationGRPC(t *testing.T) {
+	recorder, provider := setupPropagation(t)
+
+	server := proxygrpc.NewServer(tracing.Wrap(test.NewDummyDA(), provider), grpc.Creds(insecure.NewCredentials()),
+		proxygrpc.WithUnauthenticatedAccess(auth.AllPerms...))
+	lis, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	go func() {
+		_ = server.Serve(lis)
+	}()
+	defer server.Stop()
+
+	client := proxygrpc.NewClient()
+	err = client.Start(lis.Addr().String(), grpc.WithTransportCredentials(insecure.NewCredentials()))
+	require.NoError(t, err)
+	defer client.Stop() //nolint:errcheck
+
+	assertPropagated(t, recorder, tracing.Wrap(client, provider))
+}
+
+func TestPropagationJSONRPC(t *testing.T) {
+	recorder, provider := setupPropagation(t)
+
+	server := proxyjsonrpc.NewServer("127.0.0.1", "0", tracing.Wrap(test.NewDummyDA(), provider),
+		proxyjsonrpc.WithUnauthenticatedAccess(auth.AllPerms...))
+	require.NoError(t, server.Start(context.Background()))
+	t.Cleanup(func() {
+		require.NoError(t, server.Stop(context.Background()))
+	})
+
+	client, err := proxyjsonrpc.NewClient(context.Background(), "http://"+server.Addr().String(), "")
+	require.NoError(t, err)
+	defer client.Close()
+
+	assertPropagated(t, recorder, tracing.Wrap(&client.DA, provider))
+}
+
+// setupPropagation configures global W3C trace context propagator for the duration of the test.
+func setupPropagation(t *testing.T) (*tracetest.SpanRecorder, *sdktrace.TracerProvider) {
+	propagator := otel.GetTextMapPropagator()
+	otel.SetTextMapPropagator(propagation.TraceContext{})
+	t.Cleanup(func() {
+		otel.SetTextMapPropagator(propagator)
+	})
+	recorder := tracetest.NewSpanRecorder()
+	return recorder, sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(recorder))
+}
+
+// assertPropagated checks that span created by server is a child of span created by client.
+func assertPropagated(t *testing.T, recorder *tracetest.SpanRecorder, client da.DA) {
+	_, err := client.MaxBlobSize(context.Background())
+	require.NoError(t, err)
+
+	// server span ends first
+	spans := recorder.Ended()
+	require.Len(t, spans, 2)
+	serverSpan, clientSpan := spans[0], spans[1]
+	assert.Equal(t, "da.MaxBlobSize", serverSpan.Name())
+	assert.Equal(t, "da.MaxBlobSize", clientSpan.Name())
+	assert.False(t, clientSpan.Parent().IsValid())
+	assert.True(t, serverSpan.Parent().IsRemote())
+	assert.Equal(t, clientSpan.SpanContext().TraceID(), serverSpan.SpanContext().TraceID())
+	assert.Equal(t, clientSpan.SpanContext().SpanID(), serverSpan.Parent().SpanID())
+}
+
+func assertAttrs(t *testing.T, span sdktrace.ReadOnlySpan, expected map[attribute.Key]attribute.Value) {
+	attrs := make(map[attribute.Key]attribute.Value)
+	for _, attr := range span.Attributes() {
+		attrs[attr.Key] = attr.Value
+	}
+	for key, value := range expected {
+		assert.Equal(t, value, attrs[key], key)
+	}
+}
@@ -29,6 +29,38 @@ var _ = math.Inf
 // proto package needs to be updated.
 const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
+// SubmissionState is the state of submission identified by Receipt.
+type SubmissionState int32
+
+const (
+	SubmissionState_SUBMISSION_STATE_UNSPECIFIED SubmissionState = 0
+	SubmissionState_SUBMISSION_STATE_PENDING     SubmissionState = 1
+	SubmissionState_SUBMISSION_STATE_INCLUDED    SubmissionState = 2
+	SubmissionState_SUBMISSION_STATE_FAILED      SubmissionState = 3
+)
+
+var SubmissionState_name = map[int32]string{
+	0: "SUBMISSION_STATE_UNSPECIFIED",
+	1: "SUBMISSION_STATE_PENDING",
+	2: "SUBMISSION_STATE_INCLUDED",
+	3: "SUBMISSION_STATE_FAILED",
+}
+
+var SubmissionState_value = map[string]int32{
+	"SUBMISSION_STATE_UNSPECIFIED": 0,
+	"SUBMISSION_STATE_PENDING":     1,
+	"SUBMISSION_STATE_INCLUDED":    2,
+	"SUBMISSION_STATE_FAILED":      3,
+}
+
+func (x SubmissionState) String() string {
+	return proto.EnumName(SubmissionState_name, int32(x))
+}
+
+func (SubmissionState) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_feb508392bc12c0f, []int{0}
+}
+
 type ErrorCode int32
 
 const (
@@ -41,6 +73,9 @@ const (
 	ErrorCode_ERROR_CODE_TX_TOO_LARGE                  ErrorCode = 32006
 	ErrorCode_ERROR_CODE_CONTEXT_DEADLINE              ErrorCode = 32007
 	ErrorCode_ERROR_CODE_FUTURE_HEIGHT                 ErrorCode = 32008
+	ErrorCode_ERROR_CODE_RECEIPT_NOT_FOUND             ErrorCode = 32009
+	ErrorCode_ERROR_CODE_INVALID_NAMESPACE             ErrorCode = 32010
+	ErrorCode_ERROR_CODE_NOT_SUPPORTED                 ErrorCode = 32011
 )
 
 var ErrorCode_name = map[int32]string{
@@ -53,6 +88,9 @@ var ErrorCode_name = map[int32]string{
 	32006: "ERROR_CODE_TX_TOO_LARGE",
 	32007: "ERROR_CODE_CONTEXT_DEADLINE",
 	32008: "ERROR_CODE_FUTURE_HEIGHT",
+	32009: "ERROR_CODE_RECEIPT_NOT_FOUND",
+	32010: "ERROR_CODE_INVALID_NAMESPACE",
+	32011: "ERROR_CODE_NOT_SUPPORTED",
 }
 
 var ErrorCode_value = map[string]int32{
@@ -65,6 +103,9 @@ var ErrorCode_value = map[string]int32{
 	"ERROR_CODE_TX_TOO_LARGE":                  32006,
 	"ERROR_CODE_CONTEXT_DEADLINE":              32007,
 	"ERROR_CODE_FUTURE_HEIGHT":                 32008,
+	"ERROR_CODE_RECEIPT_NOT_FOUND":             32009,
+	"ERROR_CODE_INVALID_NAMESPACE":             32010,
+	"ERROR_CODE_NOT_SUPPORTED":                 32011,
 }
 
 func (x ErrorCode) String() string {
@@ -72,7 +113,7 @@ func (x ErrorCode) String() string {
 }
 
 func (ErrorCode) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_feb508392bc12c0f, []int{0}
+	return fileDescriptor_feb508392bc12c0f, []int{1}
 }
 
 // Namespace is the location for the blob to be submitted to, if supported by the DA layer.
@@ -300,6 +341,51 @@ func (m *Proof) GetValue() []byte {
 	return nil
 }
 
+// Receipt should contain serialized data required by the implementation to track the status of submission.
+type Receipt struct {
+	Value []byte `protobuf:"bytes,1,opt,name=value,proto3" json:"value,omitempty"`
+}
+
+func (m *Receipt) Reset()         { *m = Receipt{} }
+func (m *Receipt) String() string { return proto.CompactTextString(m) }
+func (*Receipt) ProtoMessage()    {}
+func (*Receipt) Descriptor() ([]byte, []int) {
+	return fileDescriptor_feb508392bc12c0f, []int{5}
+}
+func (m *Receipt) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Receipt) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Receipt.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Receipt) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Receipt.Merge(m, src)
+}
+func (m *Receipt) XXX_Size() int {
+	return m.Size()
+}
+func (m *Receipt) XXX_DiscardUnknown() {
+	xxx_messageInfo_Receipt.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Receipt proto.InternalMessageInfo
+
+func (m *Receipt) GetValue() []byte {
+	if m != nil {
+		return m.Value
+	}
+	return nil
+}
+
 // MaxBlobSizeRequest is the request type for the MaxBlobSize rpc method.
 type MaxBlobSizeRequest struct {
 }
@@ -308,7 +394,7 @@ func (m *MaxBlobSizeRequest) Reset()         { *m = MaxBlobSizeRequest{} }
 func (m *MaxBlobSizeRequest) String() string { return proto.CompactTextString(m) }
 func (*MaxBlobSizeRequest) ProtoMessage()    {}
 func (*MaxBlobSizeRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_feb508392bc12c0f, []int{5}
+	return fileDescriptor_feb508392bc12c0f, []int{6}
 }
 func (m *MaxBlobSizeRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -346,7 +432,7 @@ func (m *MaxBlobSizeResponse) Reset()         { *m = MaxBlobSizeResponse{} }
 func (m *MaxBlobSizeResponse) String() string { return proto.CompactTextString(m) }
 func (*MaxBlobSizeResponse) ProtoMessage()    {}
 func (*MaxBlobSizeResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_feb508392bc12c0f, []int{6}
+	return fileDescriptor_feb508392bc12c0f, []int{7}
 }
 func (m *MaxBlobSizeResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -392,7 +478,7 @@ func (m *GetRequest) Reset()         { *m = GetRequest{} }
 func (m *GetRequest) String() string { return proto.CompactTextString(m) }
 func (*GetRequest) ProtoMessage()    {}
 func (*GetRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_feb508392bc12c0f, []int{7}
+	return fileDescriptor_feb508392bc12c0f, []int{8}
 }
 func (m *GetRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -444,7 +530,7 @@ func (m *GetResponse) Reset()         { *m = GetResponse{} }
 func (m *GetResponse) String() string { return proto.CompactTextString(m) }
 func (*GetResponse) ProtoMessage()    {}
 func (*GetResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_feb508392bc12c0f, []int{8}
+	return fileDescriptor_feb508392bc12c0f, []int{9}
 }
 func (m *GetResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -490,7 +576,7 @@ func (m *GetIdsRequest) Reset()         { *m = GetIdsRequest{} }
 func (m *GetIdsRequest) String() string { return proto.CompactTextString(m) }
 func (*GetIdsRequest) ProtoMessage()    {}
 func (*GetIdsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_feb508392bc12c0f, []int{9}
+	return fileDescriptor_feb508392bc12c0f, []int{10}
 }
 func (m *GetIdsRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -543,7 +629,7 @@ func (m *GetIdsResponse) Reset()         { *m = GetIdsResponse{} }
 func (m *GetIdsResponse) String() string { return proto.CompactTextString(m) }
 func (*GetIdsResponse) ProtoMessage()    {}
 func (*GetIdsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_feb508392bc12c0f, []int{10}
+	return fileDescriptor_feb508392bc12c0f, []int{11}
 }
 func (m *GetIdsResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -596,7 +682,7 @@ func (m *GetProofsRequest) Reset()         { *m = GetProofsRequest{} }
 func (m *GetProofsRequest) String() string { return proto.CompactTextString(m) }
 func (*GetProofsRequest) ProtoMessage()    {}
 func (*GetProofsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_feb508392bc12c0f, []int{11}
+	return fileDescriptor_feb508392bc12c0f, []int{12}
 }
 func (m *GetProofsRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -648,7 +734,7 @@ func (m *GetProofsResponse) Reset()         { *m = GetProofsResponse{} }
 func (m *GetProofsResponse) String() string { return proto.CompactTextString(m) }
 func (*GetProofsResponse) ProtoMessage()    {}
 func (*GetProofsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_feb508392bc12c0f, []int{12}
+	return fileDescriptor_feb508392bc12c0f, []int{13}
 }
 func (m *GetProofsResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -694,7 +780,7 @@ func (m *CommitRequest) Reset()         { *m = CommitRequest{} }
 func (m *CommitRequest) String() string { return proto.CompactTextString(m) }
 func (*CommitRequest) ProtoMessage()    {}
 func (*CommitRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_feb508392bc12c0f, []int{13}
+	return fileDescriptor_feb508392bc12c0f, []int{14}
 }
 func (m *CommitRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -746,7 +832,7 @@ func (m *CommitResponse) Reset()         { *m = CommitResponse{} }
 func (m *CommitResponse) String() string { return proto.CompactTextString(m) }
 func (*CommitResponse) ProtoMessage()    {}
 func (*CommitResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_feb508392bc12c0f, []int{14}
+	return fileDescriptor_feb508392bc12c0f, []int{15}
 }
 func (m *CommitResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -794,7 +880,7 @@ func (m *SubmitRequest) Reset()         { *m = SubmitRequest{} }
 func (m *SubmitRequest) String() string { return proto.CompactTextString(m) }
 func (*SubmitRequest) ProtoMessage()    {}
 func (*SubmitRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_feb508392bc12c0f, []int{15}
+	return fileDescriptor_feb508392bc12c0f, []int{16}
 }
 func (m *SubmitRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -860,7 +946,7 @@ func (m *SubmitResponse) Reset()         { *m = SubmitResponse{} }
 func (m *SubmitResponse) String() string { return proto.CompactTextString(m) }
 func (*SubmitResponse) ProtoMessage()    {}
 func (*SubmitResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_feb508392bc12c0f, []int{16}
+	return fileDescriptor_feb508392bc12c0f, []int{17}
 }
 func (m *SubmitResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -907,7 +993,7 @@ func (m *ValidateRequest) Reset()         { *m = ValidateRequest{} }
 func (m *ValidateRequest) String() string { return proto.CompactTextString(m) }
 func (*ValidateRequest) ProtoMessage()    {}
 func (*ValidateRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_feb508392bc12c0f, []int{17}
+	return fileDescriptor_feb508392bc12c0f, []int{18}
 }
 func (m *ValidateRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -966,7 +1052,7 @@ func (m *ValidateResponse) Reset()         { *m = ValidateResponse{} }
 func (m *ValidateResponse) String() string { return proto.CompactTextString(m) }
 func (*ValidateResponse) ProtoMessage()    {}
 func (*ValidateResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_feb508392bc12c0f, []int{18}
+	return fileDescriptor_feb508392bc12c0f, []int{19}
 }
 func (m *ValidateResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1002,22 +1088,23 @@ func (m *ValidateResponse) GetResults() []bool {
 	return nil
 }
 
-type ErrorDetails struct {
-	Code ErrorCode `protobuf:"varint,1,opt,name=code,proto3,enum=da.ErrorCode" json:"code,omitempty"`
+// SubscribeRequest is the request type for the Subscribe rpc method.
+type SubscribeRequest struct {
+	Namespace *Namespace `protobuf:"bytes,1,opt,name=namespace,proto3" json:"namespace,omitempty"`
 }
 
-func (m *ErrorDetails) Reset()         { *m = ErrorDetails{} }
-func (m *ErrorDetails) String() string { return proto.CompactTextString(m) }
-func (*ErrorDetails) ProtoMessage()    {}
-func (*ErrorDetails) Descriptor() ([]byte, []int) {
-	return fileDescriptor_feb508392bc12c0f, []int{19}
+func (m *SubscribeRequest) Reset()         { *m = SubscribeRequest{} }
+func (m *SubscribeRequest) String() string { return proto.CompactTextString(m) }
+func (*SubscribeRequest) ProtoMessage()    {}
+func (*SubscribeRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_feb508392bc12c0f, []int{20}
 }
-func (m *ErrorDetails) XXX_Unmarshal(b []byte) error {
+func (m *SubscribeRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *ErrorDetails) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *SubscribeRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_ErrorDetails.Marshal(b, m, deterministic)
+		return xxx_messageInfo_SubscribeRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1027,1527 +1114,4956 @@ func (m *ErrorDetails) XXX_Marshal(b []byte, deterministic bool) ([]byte, error)
 		return b[:n], nil
 	}
 }
-func (m *ErrorDetails) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_ErrorDetails.Merge(m, src)
+func (m *SubscribeRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SubscribeRequest.Merge(m, src)
 }
-func (m *ErrorDetails) XXX_Size() int {
+func (m *SubscribeRequest) XXX_Size() int {
 	return m.Size()
 }
-func (m *ErrorDetails) XXX_DiscardUnknown() {
-	xxx_messageInfo_ErrorDetails.DiscardUnknown(m)
+func (m *SubscribeRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_SubscribeRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_ErrorDetails proto.InternalMessageInfo
+var xxx_messageInfo_SubscribeRequest proto.InternalMessageInfo
 
-func (m *ErrorDetails) GetCode() ErrorCode {
+func (m *SubscribeRequest) GetNamespace() *Namespace {
 	if m != nil {
-		return m.Code
+		return m.Namespace
 	}
-	return ErrorCode_ERROR_CODE_UNSPECIFIED
+	return nil
 }
 
-func init() {
-	proto.RegisterEnum("da.ErrorCode", ErrorCode_name, ErrorCode_value)
-	proto.RegisterType((*Namespace)(nil), "da.Namespace")
-	proto.RegisterType((*Blob)(nil), "da.Blob")
-	proto.RegisterType((*ID)(nil), "da.ID")
-	proto.RegisterType((*Commitment)(nil), "da.Commitment")
-	proto.RegisterType((*Proof)(nil), "da.Proof")
-	proto.RegisterType((*MaxBlobSizeRequest)(nil), "da.MaxBlobSizeRequest")
-	proto.RegisterType((*MaxBlobSizeResponse)(nil), "da.MaxBlobSizeResponse")
-	proto.RegisterType((*GetRequest)(nil), "da.GetRequest")
-	proto.RegisterType((*GetResponse)(nil), "da.GetResponse")
-	proto.RegisterType((*GetIdsRequest)(nil), "da.GetIdsRequest")
-	proto.RegisterType((*GetIdsResponse)(nil), "da.GetIdsResponse")
-	proto.RegisterType((*GetProofsRequest)(nil), "da.GetProofsRequest")
-	proto.RegisterType((*GetProofsResponse)(nil), "da.GetProofsResponse")
-	proto.RegisterType((*CommitRequest)(nil), "da.CommitRequest")
-	proto.RegisterType((*CommitResponse)(nil), "da.CommitResponse")
-	proto.RegisterType((*SubmitRequest)(nil), "da.SubmitRequest")
-	proto.RegisterType((*SubmitResponse)(nil), "da.SubmitResponse")
-	proto.RegisterType((*ValidateRequest)(nil), "da.ValidateRequest")
-	proto.RegisterType((*ValidateResponse)(nil), "da.ValidateResponse")
-	proto.RegisterType((*ErrorDetails)(nil), "da.ErrorDetails")
+// SubscribeResponse is the response type for the Subscribe rpc method.
+type SubscribeResponse struct {
+	Height    uint64           `protobuf:"varint,1,opt,name=height,proto3" json:"height,omitempty"`
+	Ids       []*ID            `protobuf:"bytes,2,rep,name=ids,proto3" json:"ids,omitempty"`
+	Timestamp *types.Timestamp `protobuf:"bytes,3,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
 }
 
-func init() { proto.RegisterFile("da/da.proto", fileDescriptor_feb508392bc12c0f) }
-
-var fileDescriptor_feb508392bc12c0f = []byte{
-	// 888 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x54, 0x4d, 0x6f, 0xdb, 0x46,
-	0x10, 0x15, 0x25, 0x5b, 0xb1, 0x46, 0x91, 0xc2, 0x6c, 0x5c, 0x47, 0x55, 0x1a, 0xd9, 0x5e, 0xa0,
-	0x85, 0x90, 0xb6, 0x72, 0xe3, 0x02, 0xfd, 0x3a, 0x55, 0x22, 0xd7, 0x0a, 0x01, 0x89, 0x74, 0x57,
-	0x54, 0x90, 0x14, 0x05, 0x16, 0x94, 0xb9, 0x51, 0x08, 0x48, 0xa6, 0x2a, 0x52, 0x41, 0x90, 0x9e,
-	0xfa, 0x91, 0xb6, 0xc7, 0x02, 0xfd, 0x53, 0x39, 0xe6, 0xd8, 0x63, 0x61, 0xff, 0x0b, 0x9d, 0x0a,
-	0x72, 0x49, 0x8a, 0xb4, 0xab, 0x1a, 0x06, 0x7a, 0xdc, 0x79, 0x6f, 0x66, 0xde, 0xee, 0xce, 0x3c,
-	0x28, 0xdb, 0xd6, 0x81, 0x6d, 0xb5, 0x66, 0x73, 0xd7, 0x77, 0x51, 0xde, 0xb6, 0xea, 0xbb, 0x63,
-	0xd7, 0x1d, 0x4f, 0xf8, 0x41, 0x18, 0x19, 0x2d, 0x9e, 0x1d, 0xf8, 0xce, 0x94, 0x7b, 0xbe, 0x35,
-	0x9d, 0x09, 0x12, 0xde, 0x87, 0x92, 0x6e, 0x4d, 0xb9, 0x37, 0xb3, 0x4e, 0x38, 0xda, 0x86, 0xcd,
-	0x17, 0xd6, 0x64, 0xc1, 0x6b, 0xd2, 0x9e, 0xd4, 0xbc, 0x49, 0xc5, 0x01, 0xbf, 0x07, 0x1b, 0x9d,
-	0x89, 0x3b, 0x5a, 0x83, 0xd6, 0x21, 0xaf, 0xa9, 0x6b, 0x30, 0x0c, 0xa0, 0xb8, 0xd3, 0xa9, 0xe3,
-	0x4f, 0xf9, 0xa9, 0xbf, 0x86, 0x73, 0x1f, 0x36, 0x8f, 0xe7, 0xae, 0xfb, 0x6c, 0x0d, 0xbc, 0x0d,
-	0xa8, 0x6f, 0xbd, 0x0c, 0xfa, 0x0f, 0x9c, 0x57, 0x9c, 0xf2, 0xef, 0x17, 0xdc, 0xf3, 0xf1, 0x97,
-	0x70, 0x27, 0x13, 0xf5, 0x66, 0xee, 0xa9, 0xc7, 0x11, 0x86, 0xca, 0xd4, 0x7a, 0xc9, 0x46, 0x13,
-	0x77, 0xc4, 0x3c, 0xe7, 0x95, 0x28, 0xb5, 0x41, 0xcb, 0xd3, 0x15, 0x17, 0x0f, 0x00, 0xba, 0xdc,
-	0x8f, 0x0a, 0xa1, 0x1a, 0x14, 0x1c, 0xdb, 0xab, 0x49, 0x7b, 0x85, 0x66, 0xf9, 0xb0, 0xd8, 0xb2,
-	0xad, 0x96, 0xa6, 0xd2, 0x20, 0x84, 0x3e, 0x84, 0xd2, 0x69, 0xfc, 0x30, 0xb5, 0xfc, 0x9e, 0xd4,
-	0x2c, 0x1f, 0x56, 0x02, 0x3c, 0x79, 0x2d, 0xba, 0xc2, 0xf1, 0xc7, 0x50, 0x0e, 0x8b, 0x46, 0x3a,
-	0x1a, 0xb0, 0x19, 0x68, 0x88, 0xeb, 0x6e, 0x05, 0x79, 0x81, 0x00, 0x2a, 0xc2, 0xd8, 0x84, 0x4a,
-	0x97, 0xfb, 0x9a, 0xed, 0xc5, 0x32, 0x76, 0xa0, 0xf8, 0x9c, 0x3b, 0xe3, 0xe7, 0x7e, 0xa4, 0x38,
-	0x3a, 0x5d, 0x4f, 0x84, 0x0d, 0xd5, 0xb8, 0x6a, 0xa4, 0x63, 0xfd, 0xed, 0xbe, 0x80, 0x52, 0x32,
-	0x09, 0x51, 0xe1, 0x7a, 0x4b, 0xcc, 0x4a, 0x2b, 0x9e, 0x95, 0x96, 0x19, 0x33, 0xe8, 0x8a, 0x8c,
-	0x9f, 0x82, 0xdc, 0xe5, 0x7e, 0xf8, 0x65, 0xde, 0xff, 0xfc, 0x8a, 0x9f, 0xc1, 0xed, 0x54, 0xe9,
-	0xe8, 0x0e, 0xfb, 0x50, 0x9c, 0x85, 0x91, 0xa8, 0x7c, 0x29, 0x48, 0x0f, 0x39, 0x34, 0x02, 0xf0,
-	0x77, 0x50, 0x11, 0x63, 0x16, 0xeb, 0xb9, 0xe2, 0xfd, 0xaf, 0xa7, 0xaa, 0x03, 0xd5, 0xb8, 0x7a,
-	0x24, 0xe9, 0x13, 0x28, 0x9f, 0x24, 0x63, 0x1d, 0x37, 0xa9, 0x06, 0x05, 0x56, 0xd3, 0x4e, 0xd3,
-	0x14, 0xfc, 0xa7, 0x04, 0x95, 0xc1, 0x62, 0x74, 0x0d, 0x89, 0xf7, 0xa0, 0x34, 0xb6, 0x3c, 0x36,
-	0x9b, 0x3b, 0x91, 0x44, 0x89, 0x6e, 0x8d, 0x2d, 0xef, 0x38, 0x38, 0x67, 0xf5, 0x17, 0xfe, 0x5b,
-	0x3f, 0xaa, 0xc1, 0x0d, 0x77, 0xe6, 0x3b, 0xee, 0xa9, 0x57, 0xdb, 0x08, 0x37, 0x2b, 0x3e, 0xe2,
-	0x07, 0x50, 0x8d, 0x45, 0x5d, 0x35, 0x30, 0xf8, 0x07, 0xb8, 0xf5, 0xd8, 0x9a, 0x38, 0xb6, 0xe5,
-	0xf3, 0xab, 0x7f, 0x7d, 0xf5, 0x67, 0xf9, 0x35, 0x7f, 0x76, 0xad, 0x2b, 0xe0, 0x8f, 0x40, 0x5e,
-	0x35, 0x4f, 0xa4, 0xde, 0x98, 0x73, 0x6f, 0x31, 0x89, 0x3e, 0x60, 0x8b, 0xc6, 0x47, 0xfc, 0x10,
-	0x6e, 0x92, 0xf9, 0xdc, 0x9d, 0xab, 0xdc, 0xb7, 0x9c, 0x49, 0xa0, 0x66, 0xe3, 0xc4, 0xb5, 0x85,
-	0x19, 0x54, 0x45, 0x97, 0x10, 0x57, 0x5c, 0x9b, 0xd3, 0x10, 0x7a, 0xf0, 0x26, 0x0f, 0xa5, 0x24,
-	0x86, 0xea, 0xb0, 0x43, 0x28, 0x35, 0x28, 0x53, 0x0c, 0x95, 0xb0, 0xa1, 0x3e, 0x38, 0x26, 0x8a,
-	0x76, 0xa4, 0x11, 0x55, 0xce, 0xa1, 0x5d, 0x78, 0x37, 0x85, 0x75, 0x7a, 0x46, 0x87, 0xe9, 0x86,
-	0xc9, 0x8e, 0x8c, 0xa1, 0xae, 0xca, 0x3f, 0x2e, 0x25, 0xf4, 0x3e, 0xec, 0x5e, 0x24, 0x0c, 0xb4,
-	0x6f, 0x09, 0x33, 0x1e, 0x13, 0xca, 0x7a, 0x5a, 0x5f, 0x33, 0xe5, 0x9f, 0x96, 0x12, 0xba, 0x0f,
-	0x77, 0x53, 0x34, 0xf3, 0x09, 0x33, 0xb5, 0x3e, 0x51, 0x99, 0x31, 0x34, 0xe5, 0x9f, 0x97, 0x12,
-	0xfa, 0x00, 0xf6, 0xb2, 0x70, 0xbb, 0x47, 0x49, 0x5b, 0x7d, 0xca, 0x34, 0x9d, 0xf5, 0x49, 0xff,
-	0xd8, 0x30, 0x7a, 0xf2, 0x2f, 0x4b, 0x09, 0xb5, 0xa0, 0x99, 0xe5, 0x69, 0xba, 0x62, 0x50, 0x4a,
-	0x14, 0x93, 0xb5, 0x15, 0xc5, 0x18, 0xea, 0x26, 0x1b, 0x90, 0x6f, 0x86, 0x44, 0x57, 0x88, 0xfc,
-	0xfa, 0x5f, 0xdb, 0x1a, 0x06, 0xeb, 0xb5, 0x69, 0x97, 0xc8, 0xbf, 0x2e, 0x25, 0xb4, 0x0f, 0xf7,
-	0x52, 0xb0, 0x62, 0xe8, 0x26, 0x79, 0x62, 0x32, 0x95, 0xb4, 0xd5, 0x9e, 0xa6, 0x13, 0xf9, 0xb7,
-	0xa5, 0x84, 0x1a, 0x50, 0x4b, 0x51, 0x8e, 0x86, 0xe6, 0x90, 0x12, 0xf6, 0x88, 0x68, 0xdd, 0x47,
-	0xa6, 0xfc, 0xfb, 0x52, 0x3a, 0x7c, 0x5d, 0x80, 0x92, 0xda, 0x1e, 0xf0, 0xf9, 0x8b, 0x60, 0x52,
-	0xbf, 0x86, 0x72, 0xca, 0xa8, 0xd1, 0x4e, 0xf0, 0xf8, 0x97, 0xfd, 0xbc, 0x7e, 0xf7, 0x52, 0x5c,
-	0xfc, 0x32, 0xce, 0xa1, 0x26, 0x14, 0xba, 0xdc, 0x47, 0xe1, 0x7a, 0xad, 0x8c, 0xbb, 0x7e, 0x2b,
-	0x39, 0x27, 0xcc, 0x87, 0x50, 0x14, 0xfe, 0x87, 0x6e, 0x47, 0xe0, 0xca, 0x61, 0xeb, 0x28, 0x1d,
-	0x4a, 0x52, 0xbe, 0x82, 0x52, 0xe2, 0x38, 0x68, 0x3b, 0xa2, 0x64, 0xbc, 0xad, 0xfe, 0xce, 0x85,
-	0x68, 0xba, 0x9d, 0x58, 0x77, 0xd1, 0x2e, 0xe3, 0x40, 0xa2, 0x5d, 0xd6, 0x36, 0x44, 0x8a, 0x58,
-	0x38, 0x91, 0x92, 0x71, 0x04, 0x91, 0x92, 0xdd, 0x47, 0x9c, 0x43, 0x9f, 0xc3, 0x56, 0x3c, 0xfa,
-	0xe8, 0x4e, 0xc0, 0xb8, 0xb0, 0x85, 0xf5, 0xed, 0x6c, 0x30, 0x4e, 0xec, 0xd4, 0xde, 0x9c, 0x35,
-	0xa4, 0xb7, 0x67, 0x0d, 0xe9, 0xef, 0xb3, 0x86, 0xf4, 0xc7, 0x79, 0x23, 0xf7, 0xf6, 0xbc, 0x91,
-	0xfb, 0xeb, 0xbc, 0x91, 0x1b, 0x15, 0x43, 0x83, 0xff, 0xf4, 0x9f, 0x00, 0x00, 0x00, 0xff, 0xff,
-	0x07, 0xc0, 0x94, 0xa0, 0x2d, 0x08, 0x00, 0x00,
+func (m *SubscribeResponse) Reset()         { *m = SubscribeResponse{} }
+func (m *SubscribeResponse) String() string { return proto.CompactTextString(m) }
+func (*SubscribeResponse) ProtoMessage()    {}
+func (*SubscribeResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_feb508392bc12c0f, []int{21}
 }
-
-// Reference imports to suppress errors if they are not otherwise used.
-var _ context.Context
-var _ grpc.ClientConn
-
-// This is a compile-time assertion to ensure that this generated file
-// is compatible with the grpc package it is being compiled against.
-const _ = grpc.SupportPackageIsVersion4
-
-// DAServiceClient is the client API for DAService service.
-//
-// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
-type DAServiceClient interface {
-	// MaxBlobSize returns the maximum blob size
-	MaxBlobSize(ctx context.Context, in *MaxBlobSizeRequest, opts ...grpc.CallOption) (*MaxBlobSizeResponse, error)
-	// Get returns Blob for each given ID, or an error.
-	Get(ctx context.Context, in *GetRequest, opts ...grpc.CallOption) (*GetResponse, error)
-	// GetIds returns IDs of all Blobs located in DA at given height.
-	GetIds(ctx context.Context, in *GetIdsRequest, opts ...grpc.CallOption) (*GetIdsResponse, error)
-	// GetProofs returns inclusion Proofs for all Blobs located in DA at given height.
-	GetProofs(ctx context.Context, in *GetProofsRequest, opts ...grpc.CallOption) (*GetProofsResponse, error)
-	// Commit creates a Commitment for each given Blob.
-	Commit(ctx context.Context, in *CommitRequest, opts ...grpc.CallOption) (*CommitResponse, error)
-	// Submit submits the given Blobs to Data Availability layer.
-	Submit(ctx context.Context, in *SubmitRequest, opts ...grpc.CallOption) (*SubmitResponse, error)
-	// Validate validates Commitments against corresponding Proofs. This should be possible without retrieving Blob.
-	Validate(ctx context.Context, in *ValidateRequest, opts ...grpc.CallOption) (*ValidateResponse, error)
+func (m *SubscribeResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-
-type dAServiceClient struct {
-	cc grpc1.ClientConn
+func (m *SubscribeResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SubscribeResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
 }
-
-func NewDAServiceClient(cc grpc1.ClientConn) DAServiceClient {
-	return &dAServiceClient{cc}
+func (m *SubscribeResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SubscribeResponse.Merge(m, src)
 }
-
-func (c *dAServiceClient) MaxBlobSize(ctx context.Context, in *MaxBlobSizeRequest, opts ...grpc.CallOption) (*MaxBlobSizeResponse, error) {
-	out := new(MaxBlobSizeResponse)
-	err := c.cc.Invoke(ctx, "/da.DAService/MaxBlobSize", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+func (m *SubscribeResponse) XXX_Size() int {
+	return m.Size()
 }
-
-func (c *dAServiceClient) Get(ctx context.Context, in *GetRequest, opts ...grpc.CallOption) (*GetResponse, error) {
-	out := new(GetResponse)
-	err := c.cc.Invoke(ctx, "/da.DAService/Get", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+func (m *SubscribeResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_SubscribeResponse.DiscardUnknown(m)
 }
 
-func (c *dAServiceClient) GetIds(ctx context.Context, in *GetIdsRequest, opts ...grpc.CallOption) (*GetIdsResponse, error) {
-	out := new(GetIdsResponse)
-	err := c.cc.Invoke(ctx, "/da.DAService/GetIds", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_SubscribeResponse proto.InternalMessageInfo
+
+func (m *SubscribeResponse) GetHeight() uint64 {
+	if m != nil {
+		return m.Height
 	}
-	return out, nil
+	return 0
 }
 
-func (c *dAServiceClient) GetProofs(ctx context.Context, in *GetProofsRequest, opts ...grpc.CallOption) (*GetProofsResponse, error) {
-	out := new(GetProofsResponse)
-	err := c.cc.Invoke(ctx, "/da.DAService/GetProofs", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *SubscribeResponse) GetIds() []*ID {
+	if m != nil {
+		return m.Ids
 	}
-	return out, nil
+	return nil
 }
 
-func (c *dAServiceClient) Commit(ctx context.Context, in *CommitRequest, opts ...grpc.CallOption) (*CommitResponse, error) {
-	out := new(CommitResponse)
-	err := c.cc.Invoke(ctx, "/da.DAService/Commit", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *SubscribeResponse) GetTimestamp() *types.Timestamp {
+	if m != nil {
+		return m.Timestamp
 	}
-	return out, nil
+	return nil
 }
 
-func (c *dAServiceClient) Submit(ctx context.Context, in *SubmitRequest, opts ...grpc.CallOption) (*SubmitResponse, error) {
-	out := new(SubmitResponse)
-	err := c.cc.Invoke(ctx, "/da.DAService/Submit", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+// LatestHeightRequest is the request type for the LatestHeight rpc method.
+type LatestHeightRequest struct {
 }
 
-func (c *dAServiceClient) Validate(ctx context.Context, in *ValidateRequest, opts ...grpc.CallOption) (*ValidateResponse, error) {
-	out := new(ValidateResponse)
-	err := c.cc.Invoke(ctx, "/da.DAService/Validate", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *LatestHeightRequest) Reset()         { *m = LatestHeightRequest{} }
+func (m *LatestHeightRequest) String() string { return proto.CompactTextString(m) }
+func (*LatestHeightRequest) ProtoMessage()    {}
+func (*LatestHeightRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_feb508392bc12c0f, []int{22}
+}
+func (m *LatestHeightRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *LatestHeightRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_LatestHeightRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
 }
-
-// DAServiceServer is the server API for DAService service.
-type DAServiceServer interface {
-	// MaxBlobSize returns the maximum blob size
-	MaxBlobSize(context.Context, *MaxBlobSizeRequest) (*MaxBlobSizeResponse, error)
-	// Get returns Blob for each given ID, or an error.
-	Get(context.Context, *GetRequest) (*GetResponse, error)
-	// GetIds returns IDs of all Blobs located in DA at given height.
-	GetIds(context.Context, *GetIdsRequest) (*GetIdsResponse, error)
-	// GetProofs returns inclusion Proofs for all Blobs located in DA at given height.
-	GetProofs(context.Context, *GetProofsRequest) (*GetProofsResponse, error)
-	// Commit creates a Commitment for each given Blob.
-	Commit(context.Context, *CommitRequest) (*CommitResponse, error)
-	// Submit submits the given Blobs to Data Availability layer.
-	Submit(context.Context, *SubmitRequest) (*SubmitResponse, error)
-	// Validate validates Commitments against corresponding Proofs. This should be possible without retrieving Blob.
-	Validate(context.Context, *ValidateRequest) (*ValidateResponse, error)
+func (m *LatestHeightRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_LatestHeightRequest.Merge(m, src)
+}
+func (m *LatestHeightRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *LatestHeightRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_LatestHeightRequest.DiscardUnknown(m)
 }
 
-// UnimplementedDAServiceServer can be embedded to have forward compatible implementations.
-type UnimplementedDAServiceServer struct {
+var xxx_messageInfo_LatestHeightRequest proto.InternalMessageInfo
+
+// LatestHeightResponse is the response type for the LatestHeight rpc method.
+type LatestHeightResponse struct {
+	Height uint64 `protobuf:"varint,1,opt,name=height,proto3" json:"height,omitempty"`
 }
 
-func (*UnimplementedDAServiceServer) MaxBlobSize(ctx context.Context, req *MaxBlobSizeRequest) (*MaxBlobSizeResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method MaxBlobSize not implemented")
+func (m *LatestHeightResponse) Reset()         { *m = LatestHeightResponse{} }
+func (m *LatestHeightResponse) String() string { return proto.CompactTextString(m) }
+func (*LatestHeightResponse) ProtoMessage()    {}
+func (*LatestHeightResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_feb508392bc12c0f, []int{23}
 }
-func (*UnimplementedDAServiceServer) Get(ctx context.Context, req *GetRequest) (*GetResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Get not implemented")
-}
-func (*UnimplementedDAServiceServer) GetIds(ctx context.Context, req *GetIdsRequest) (*GetIdsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetIds not implemented")
+func (m *LatestHeightResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-func (*UnimplementedDAServiceServer) GetProofs(ctx context.Context, req *GetProofsRequest) (*GetProofsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetProofs not implemented")
+func (m *LatestHeightResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_LatestHeightResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
 }
-func (*UnimplementedDAServiceServer) Commit(ctx context.Context, req *CommitRequest) (*CommitResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Commit not implemented")
+func (m *LatestHeightResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_LatestHeightResponse.Merge(m, src)
 }
-func (*UnimplementedDAServiceServer) Submit(ctx context.Context, req *SubmitRequest) (*SubmitResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Submit not implemented")
+func (m *LatestHeightResponse) XXX_Size() int {
+	return m.Size()
 }
-func (*UnimplementedDAServiceServer) Validate(ctx context.Context, req *ValidateRequest) (*ValidateResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Validate not implemented")
+func (m *LatestHeightResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_LatestHeightResponse.DiscardUnknown(m)
 }
 
-func RegisterDAServiceServer(s grpc1.Server, srv DAServiceServer) {
-	s.RegisterService(&_DAService_serviceDesc, srv)
-}
+var xxx_messageInfo_LatestHeightResponse proto.InternalMessageInfo
 
-func _DAService_MaxBlobSize_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MaxBlobSizeRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(DAServiceServer).MaxBlobSize(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/da.DAService/MaxBlobSize",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(DAServiceServer).MaxBlobSize(ctx, req.(*MaxBlobSizeRequest))
+func (m *LatestHeightResponse) GetHeight() uint64 {
+	if m != nil {
+		return m.Height
 	}
-	return interceptor(ctx, in, info, handler)
+	return 0
 }
 
-func _DAService_Get_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(DAServiceServer).Get(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/da.DAService/Get",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(DAServiceServer).Get(ctx, req.(*GetRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+// GetAllRequest is the request type for the GetAll rpc method.
+type GetAllRequest struct {
+	Height    uint64     `protobuf:"varint,1,opt,name=height,proto3" json:"height,omitempty"`
+	Namespace *Namespace `protobuf:"bytes,2,opt,name=namespace,proto3" json:"namespace,omitempty"`
 }
 
-func _DAService_GetIds_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetIdsRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(DAServiceServer).GetIds(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/da.DAService/GetIds",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(DAServiceServer).GetIds(ctx, req.(*GetIdsRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+func (m *GetAllRequest) Reset()         { *m = GetAllRequest{} }
+func (m *GetAllRequest) String() string { return proto.CompactTextString(m) }
+func (*GetAllRequest) ProtoMessage()    {}
+func (*GetAllRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_feb508392bc12c0f, []int{24}
 }
-
-func _DAService_GetProofs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetProofsRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(DAServiceServer).GetProofs(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/da.DAService/GetProofs",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(DAServiceServer).GetProofs(ctx, req.(*GetProofsRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+func (m *GetAllRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-
-func _DAService_Commit_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(CommitRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(DAServiceServer).Commit(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/da.DAService/Commit",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(DAServiceServer).Commit(ctx, req.(*CommitRequest))
+func (m *GetAllRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_GetAllRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
+}
+func (m *GetAllRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetAllRequest.Merge(m, src)
+}
+func (m *GetAllRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *GetAllRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetAllRequest.DiscardUnknown(m)
 }
 
-func _DAService_Submit_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(SubmitRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(DAServiceServer).Submit(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/da.DAService/Submit",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(DAServiceServer).Submit(ctx, req.(*SubmitRequest))
+var xxx_messageInfo_GetAllRequest proto.InternalMessageInfo
+
+func (m *GetAllRequest) GetHeight() uint64 {
+	if m != nil {
+		return m.Height
 	}
-	return interceptor(ctx, in, info, handler)
+	return 0
 }
 
-func _DAService_Validate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ValidateRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(DAServiceServer).Validate(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/da.DAService/Validate",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(DAServiceServer).Validate(ctx, req.(*ValidateRequest))
+func (m *GetAllRequest) GetNamespace() *Namespace {
+	if m != nil {
+		return m.Namespace
 	}
-	return interceptor(ctx, in, info, handler)
+	return nil
 }
 
-var DAService_serviceDesc = _DAService_serviceDesc
-var _DAService_serviceDesc = grpc.ServiceDesc{
-	ServiceName: "da.DAService",
-	HandlerType: (*DAServiceServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "MaxBlobSize",
-			Handler:    _DAService_MaxBlobSize_Handler,
-		},
-		{
-			MethodName: "Get",
-			Handler:    _DAService_Get_Handler,
-		},
-		{
-			MethodName: "GetIds",
-			Handler:    _DAService_GetIds_Handler,
-		},
-		{
-			MethodName: "GetProofs",
-			Handler:    _DAService_GetProofs_Handler,
-		},
-		{
-			MethodName: "Commit",
-			Handler:    _DAService_Commit_Handler,
-		},
-		{
-			MethodName: "Submit",
-			Handler:    _DAService_Submit_Handler,
-		},
-		{
-			MethodName: "Validate",
-			Handler:    _DAService_Validate_Handler,
-		},
-	},
-	Streams:  []grpc.StreamDesc{},
-	Metadata: "da/da.proto",
+// GetAllResponse is the response type for the GetAll rpc method.
+type GetAllResponse struct {
+	Ids       []*ID            `protobuf:"bytes,1,rep,name=ids,proto3" json:"ids,omitempty"`
+	Blobs     []*Blob          `protobuf:"bytes,2,rep,name=blobs,proto3" json:"blobs,omitempty"`
+	Timestamp *types.Timestamp `protobuf:"bytes,3,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
 }
 
-func (m *Namespace) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *GetAllResponse) Reset()         { *m = GetAllResponse{} }
+func (m *GetAllResponse) String() string { return proto.CompactTextString(m) }
+func (*GetAllResponse) ProtoMessage()    {}
+func (*GetAllResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_feb508392bc12c0f, []int{25}
+}
+func (m *GetAllResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *GetAllResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_GetAllResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
+}
+func (m *GetAllResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetAllResponse.Merge(m, src)
+}
+func (m *GetAllResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *GetAllResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetAllResponse.DiscardUnknown(m)
 }
 
-func (m *Namespace) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+var xxx_messageInfo_GetAllResponse proto.InternalMessageInfo
+
+func (m *GetAllResponse) GetIds() []*ID {
+	if m != nil {
+		return m.Ids
+	}
+	return nil
 }
 
-func (m *Namespace) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Value) > 0 {
-		i -= len(m.Value)
-		copy(dAtA[i:], m.Value)
-		i = encodeVarintDa(dAtA, i, uint64(len(m.Value)))
-		i--
-		dAtA[i] = 0xa
+func (m *GetAllResponse) GetBlobs() []*Blob {
+	if m != nil {
+		return m.Blobs
 	}
-	return len(dAtA) - i, nil
+	return nil
 }
 
-func (m *Blob) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *GetAllResponse) GetTimestamp() *types.Timestamp {
+	if m != nil {
+		return m.Timestamp
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *Blob) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// SubmitWithReceiptResponse is the response type for the SubmitWithReceipt rpc method.
+type SubmitWithReceiptResponse struct {
+	Receipt *Receipt `protobuf:"bytes,1,opt,name=receipt,proto3" json:"receipt,omitempty"`
 }
 
-func (m *Blob) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Value) > 0 {
-		i -= len(m.Value)
-		copy(dAtA[i:], m.Value)
-		i = encodeVarintDa(dAtA, i, uint64(len(m.Value)))
-		i--
-		dAtA[i] = 0xa
+func (m *SubmitWithReceiptResponse) Reset()         { *m = SubmitWithReceiptResponse{} }
+func (m *SubmitWithReceiptResponse) String() string { return proto.CompactTextString(m) }
+func (*SubmitWithReceiptResponse) ProtoMessage()    {}
+func (*SubmitWithReceiptResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_feb508392bc12c0f, []int{26}
+}
+func (m *SubmitWithReceiptResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SubmitWithReceiptResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SubmitWithReceiptResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *SubmitWithReceiptResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SubmitWithReceiptResponse.Merge(m, src)
+}
+func (m *SubmitWithReceiptResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *SubmitWithReceiptResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_SubmitWithReceiptResponse.DiscardUnknown(m)
 }
 
-func (m *ID) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_SubmitWithReceiptResponse proto.InternalMessageInfo
+
+func (m *SubmitWithReceiptResponse) GetReceipt() *Receipt {
+	if m != nil {
+		return m.Receipt
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *ID) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// GetSubmissionStatusRequest is the request type for the GetSubmissionStatus rpc method.
+type GetSubmissionStatusRequest struct {
+	Receipt *Receipt `protobuf:"bytes,1,opt,name=receipt,proto3" json:"receipt,omitempty"`
 }
 
-func (m *ID) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Value) > 0 {
-		i -= len(m.Value)
-		copy(dAtA[i:], m.Value)
-		i = encodeVarintDa(dAtA, i, uint64(len(m.Value)))
-		i--
-		dAtA[i] = 0xa
+func (m *GetSubmissionStatusRequest) Reset()         { *m = GetSubmissionStatusRequest{} }
+func (m *GetSubmissionStatusRequest) String() string { return proto.CompactTextString(m) }
+func (*GetSubmissionStatusRequest) ProtoMessage()    {}
+func (*GetSubmissionStatusRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_feb508392bc12c0f, []int{27}
+}
+func (m *GetSubmissionStatusRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *GetSubmissionStatusRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_GetSubmissionStatusRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *GetSubmissionStatusRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetSubmissionStatusRequest.Merge(m, src)
+}
+func (m *GetSubmissionStatusRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *GetSubmissionStatusRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetSubmissionStatusRequest.DiscardUnknown(m)
 }
 
-func (m *Commitment) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_GetSubmissionStatusRequest proto.InternalMessageInfo
+
+func (m *GetSubmissionStatusRequest) GetReceipt() *Receipt {
+	if m != nil {
+		return m.Receipt
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *Commitment) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// GetSubmissionStatusResponse is the response type for the GetSubmissionStatus rpc method.
+type GetSubmissionStatusResponse struct {
+	State  SubmissionState `protobuf:"varint,1,opt,name=state,proto3,enum=da.SubmissionState" json:"state,omitempty"`
+	Height uint64          `protobuf:"varint,2,opt,name=height,proto3" json:"height,omitempty"`
+	Ids    []*ID           `protobuf:"bytes,3,rep,name=ids,proto3" json:"ids,omitempty"`
+	Error  string          `protobuf:"bytes,4,opt,name=error,proto3" json:"error,omitempty"`
 }
 
-func (m *Commitment) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Value) > 0 {
-		i -= len(m.Value)
-		copy(dAtA[i:], m.Value)
-		i = encodeVarintDa(dAtA, i, uint64(len(m.Value)))
-		i--
-		dAtA[i] = 0xa
+func (m *GetSubmissionStatusResponse) Reset()         { *m = GetSubmissionStatusResponse{} }
+func (m *GetSubmissionStatusResponse) String() string { return proto.CompactTextString(m) }
+func (*GetSubmissionStatusResponse) ProtoMessage()    {}
+func (*GetSubmissionStatusResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_feb508392bc12c0f, []int{28}
+}
+func (m *GetSubmissionStatusResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *GetSubmissionStatusResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_GetSubmissionStatusResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *GetSubmissionStatusResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetSubmissionStatusResponse.Merge(m, src)
+}
+func (m *GetSubmissionStatusResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *GetSubmissionStatusResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetSubmissionStatusResponse.DiscardUnknown(m)
 }
 
-func (m *Proof) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_GetSubmissionStatusResponse proto.InternalMessageInfo
+
+func (m *GetSubmissionStatusResponse) GetState() SubmissionState {
+	if m != nil {
+		return m.State
 	}
-	return dAtA[:n], nil
+	return SubmissionState_SUBMISSION_STATE_UNSPECIFIED
 }
 
-func (m *Proof) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *GetSubmissionStatusResponse) GetHeight() uint64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
 }
 
-func (m *Proof) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Value) > 0 {
-		i -= len(m.Value)
-		copy(dAtA[i:], m.Value)
-		i = encodeVarintDa(dAtA, i, uint64(len(m.Value)))
-		i--
-		dAtA[i] = 0xa
+func (m *GetSubmissionStatusResponse) GetIds() []*ID {
+	if m != nil {
+		return m.Ids
 	}
-	return len(dAtA) - i, nil
+	return nil
 }
 
-func (m *MaxBlobSizeRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *GetSubmissionStatusResponse) GetError() string {
+	if m != nil {
+		return m.Error
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *MaxBlobSizeRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// GasPriceRequest is the request type for the GasPrice rpc method.
+type GasPriceRequest struct {
 }
 
-func (m *MaxBlobSizeRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func (m *GasPriceRequest) Reset()         { *m = GasPriceRequest{} }
+func (m *GasPriceRequest) String() string { return proto.CompactTextString(m) }
+func (*GasPriceRequest) ProtoMessage()    {}
+func (*GasPriceRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_feb508392bc12c0f, []int{29}
 }
-
-func (m *MaxBlobSizeResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *GasPriceRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *GasPriceRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_GasPriceRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
+}
+func (m *GasPriceRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GasPriceRequest.Merge(m, src)
+}
+func (m *GasPriceRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *GasPriceRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GasPriceRequest.DiscardUnknown(m)
 }
 
-func (m *MaxBlobSizeResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+var xxx_messageInfo_GasPriceRequest proto.InternalMessageInfo
+
+// GasPriceResponse is the response type for the GasPrice rpc method.
+type GasPriceResponse struct {
+	GasPrice float64 `protobuf:"fixed64,1,opt,name=gas_price,json=gasPrice,proto3" json:"gas_price,omitempty"`
 }
 
-func (m *MaxBlobSizeResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.MaxBlobSize != 0 {
-		i = encodeVarintDa(dAtA, i, uint64(m.MaxBlobSize))
-		i--
-		dAtA[i] = 0x8
+func (m *GasPriceResponse) Reset()         { *m = GasPriceResponse{} }
+func (m *GasPriceResponse) String() string { return proto.CompactTextString(m) }
+func (*GasPriceResponse) ProtoMessage()    {}
+func (*GasPriceResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_feb508392bc12c0f, []int{30}
+}
+func (m *GasPriceResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *GasPriceResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_GasPriceResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *GasPriceResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GasPriceResponse.Merge(m, src)
+}
+func (m *GasPriceResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *GasPriceResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_GasPriceResponse.DiscardUnknown(m)
 }
 
-func (m *GetRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_GasPriceResponse proto.InternalMessageInfo
+
+func (m *GasPriceResponse) GetGasPrice() float64 {
+	if m != nil {
+		return m.GasPrice
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *GetRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// GasMultiplierRequest is the request type for the GasMultiplier rpc method.
+type GasMultiplierRequest struct {
 }
 
-func (m *GetRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Namespace != nil {
-		{
-			size, err := m.Namespace.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintDa(dAtA, i, uint64(size))
+func (m *GasMultiplierRequest) Reset()         { *m = GasMultiplierRequest{} }
+func (m *GasMultiplierRequest) String() string { return proto.CompactTextString(m) }
+func (*GasMultiplierRequest) ProtoMessage()    {}
+func (*GasMultiplierRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_feb508392bc12c0f, []int{31}
+}
+func (m *GasMultiplierRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *GasMultiplierRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_GasMultiplierRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0x12
+		return b[:n], nil
 	}
-	if len(m.Ids) > 0 {
-		for iNdEx := len(m.Ids) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Ids[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintDa(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
-		}
-	}
-	return len(dAtA) - i, nil
 }
-
-func (m *GetResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *GasMultiplierRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GasMultiplierRequest.Merge(m, src)
+}
+func (m *GasMultiplierRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *GasMultiplierRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GasMultiplierRequest.DiscardUnknown(m)
 }
 
-func (m *GetResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+var xxx_messageInfo_GasMultiplierRequest proto.InternalMessageInfo
+
+// GasMultiplierResponse is the response type for the GasMultiplier rpc method.
+type GasMultiplierResponse struct {
+	GasMultiplier float64 `protobuf:"fixed64,1,opt,name=gas_multiplier,json=gasMultiplier,proto3" json:"gas_multiplier,omitempty"`
 }
 
-func (m *GetResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Blobs) > 0 {
-		for iNdEx := len(m.Blobs) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Blobs[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintDa(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
+func (m *GasMultiplierResponse) Reset()         { *m = GasMultiplierResponse{} }
+func (m *GasMultiplierResponse) String() string { return proto.CompactTextString(m) }
+func (*GasMultiplierResponse) ProtoMessage()    {}
+func (*GasMultiplierResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_feb508392bc12c0f, []int{32}
+}
+func (m *GasMultiplierResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *GasMultiplierResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_GasMultiplierResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *GasMultiplierResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GasMultiplierResponse.Merge(m, src)
+}
+func (m *GasMultiplierResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *GasMultiplierResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_GasMultiplierResponse.DiscardUnknown(m)
 }
 
-func (m *GetIdsRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_GasMultiplierResponse proto.InternalMessageInfo
+
+func (m *GasMultiplierResponse) GetGasMultiplier() float64 {
+	if m != nil {
+		return m.GasMultiplier
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *GetIdsRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// CapabilitiesRequest is the request type for the Capabilities rpc method.
+type CapabilitiesRequest struct {
 }
 
-func (m *GetIdsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Namespace != nil {
-		{
-			size, err := m.Namespace.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintDa(dAtA, i, uint64(size))
+func (m *CapabilitiesRequest) Reset()         { *m = CapabilitiesRequest{} }
+func (m *CapabilitiesRequest) String() string { return proto.CompactTextString(m) }
+func (*CapabilitiesRequest) ProtoMessage()    {}
+func (*CapabilitiesRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_feb508392bc12c0f, []int{33}
+}
+func (m *CapabilitiesRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *CapabilitiesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_CapabilitiesRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0x12
-	}
-	if m.Height != 0 {
-		i = encodeVarintDa(dAtA, i, uint64(m.Height))
-		i--
-		dAtA[i] = 0x8
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
 }
-
-func (m *GetIdsResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *CapabilitiesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CapabilitiesRequest.Merge(m, src)
+}
+func (m *CapabilitiesRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *CapabilitiesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_CapabilitiesRequest.DiscardUnknown(m)
 }
 
-func (m *GetIdsResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+var xxx_messageInfo_CapabilitiesRequest proto.InternalMessageInfo
+
+// CapabilitiesResponse is the response type for the Capabilities rpc method.
+type CapabilitiesResponse struct {
+	NamespaceSize uint32          `protobuf:"varint,1,opt,name=namespace_size,json=namespaceSize,proto3" json:"namespace_size,omitempty"`
+	OptionsSchema string          `protobuf:"bytes,2,opt,name=options_schema,json=optionsSchema,proto3" json:"options_schema,omitempty"`
+	ProofType     string          `protobuf:"bytes,3,opt,name=proof_type,json=proofType,proto3" json:"proof_type,omitempty"`
+	MaxBlobSize   uint64          `protobuf:"varint,4,opt,name=max_blob_size,json=maxBlobSize,proto3" json:"max_blob_size,omitempty"`
+	BlockTime     *types.Duration `protobuf:"bytes,5,opt,name=block_time,json=blockTime,proto3" json:"block_time,omitempty"`
+	Extensions    []string        `protobuf:"bytes,6,rep,name=extensions,proto3" json:"extensions,omitempty"`
 }
 
-func (m *GetIdsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Timestamp != nil {
-		{
-			size, err := m.Timestamp.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintDa(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.Ids) > 0 {
-		for iNdEx := len(m.Ids) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Ids[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintDa(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
+func (m *CapabilitiesResponse) Reset()         { *m = CapabilitiesResponse{} }
+func (m *CapabilitiesResponse) String() string { return proto.CompactTextString(m) }
+func (*CapabilitiesResponse) ProtoMessage()    {}
+func (*CapabilitiesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_feb508392bc12c0f, []int{34}
+}
+func (m *CapabilitiesResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *CapabilitiesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_CapabilitiesResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
 }
-
-func (m *GetProofsRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *CapabilitiesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CapabilitiesResponse.Merge(m, src)
 }
-
-func (m *GetProofsRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *CapabilitiesResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *CapabilitiesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_CapabilitiesResponse.DiscardUnknown(m)
 }
 
-func (m *GetProofsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Namespace != nil {
-		{
-			size, err := m.Namespace.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintDa(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x12
+var xxx_messageInfo_CapabilitiesResponse proto.InternalMessageInfo
+
+func (m *CapabilitiesResponse) GetNamespaceSize() uint32 {
+	if m != nil {
+		return m.NamespaceSize
 	}
-	if len(m.Ids) > 0 {
-		for iNdEx := len(m.Ids) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Ids[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintDa(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
-		}
+	return 0
+}
+
+func (m *CapabilitiesResponse) GetOptionsSchema() string {
+	if m != nil {
+		return m.OptionsSchema
 	}
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func (m *GetProofsResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *CapabilitiesResponse) GetProofType() string {
+	if m != nil {
+		return m.ProofType
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *GetProofsResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *CapabilitiesResponse) GetMaxBlobSize() uint64 {
+	if m != nil {
+		return m.MaxBlobSize
+	}
+	return 0
 }
 
-func (m *GetProofsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Proofs) > 0 {
-		for iNdEx := len(m.Proofs) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Proofs[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintDa(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
-		}
+func (m *CapabilitiesResponse) GetBlockTime() *types.Duration {
+	if m != nil {
+		return m.BlockTime
 	}
-	return len(dAtA) - i, nil
+	return nil
 }
 
-func (m *CommitRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *CapabilitiesResponse) GetExtensions() []string {
+	if m != nil {
+		return m.Extensions
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *CommitRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type ErrorDetails struct {
+	Code ErrorCode `protobuf:"varint,1,opt,name=code,proto3,enum=da.ErrorCode" json:"code,omitempty"`
 }
 
-func (m *CommitRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Namespace != nil {
-		{
-			size, err := m.Namespace.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintDa(dAtA, i, uint64(size))
+func (m *ErrorDetails) Reset()         { *m = ErrorDetails{} }
+func (m *ErrorDetails) String() string { return proto.CompactTextString(m) }
+func (*ErrorDetails) ProtoMessage()    {}
+func (*ErrorDetails) Descriptor() ([]byte, []int) {
+	return fileDescriptor_feb508392bc12c0f, []int{35}
+}
+func (m *ErrorDetails) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ErrorDetails) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ErrorDetails.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0x12
+		return b[:n], nil
 	}
-	if len(m.Blobs) > 0 {
-		for iNdEx := len(m.Blobs) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Blobs[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintDa(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
-		}
+}
+func (m *ErrorDetails) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ErrorDetails.Merge(m, src)
+}
+func (m *ErrorDetails) XXX_Size() int {
+	return m.Size()
+}
+func (m *ErrorDetails) XXX_DiscardUnknown() {
+	xxx_messageInfo_ErrorDetails.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ErrorDetails proto.InternalMessageInfo
+
+func (m *ErrorDetails) GetCode() ErrorCode {
+	if m != nil {
+		return m.Code
 	}
-	return len(dAtA) - i, nil
+	return ErrorCode_ERROR_CODE_UNSPECIFIED
 }
 
-func (m *CommitResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func init() {
+	proto.RegisterEnum("da.SubmissionState", SubmissionState_name, SubmissionState_value)
+	proto.RegisterEnum("da.ErrorCode", ErrorCode_name, ErrorCode_value)
+	proto.RegisterType((*Namespace)(nil), "da.Namespace")
+	proto.RegisterType((*Blob)(nil), "da.Blob")
+	proto.RegisterType((*ID)(nil), "da.ID")
+	proto.RegisterType((*Commitment)(nil), "da.Commitment")
+	proto.RegisterType((*Proof)(nil), "da.Proof")
+	proto.RegisterType((*Receipt)(nil), "da.Receipt")
+	proto.RegisterType((*MaxBlobSizeRequest)(nil), "da.MaxBlobSizeRequest")
+	proto.RegisterType((*MaxBlobSizeResponse)(nil), "da.MaxBlobSizeResponse")
+	proto.RegisterType((*GetRequest)(nil), "da.GetRequest")
+	proto.RegisterType((*GetResponse)(nil), "da.GetResponse")
+	proto.RegisterType((*GetIdsRequest)(nil), "da.GetIdsRequest")
+	proto.RegisterType((*GetIdsResponse)(nil), "da.GetIdsResponse")
+	proto.RegisterType((*GetProofsRequest)(nil), "da.GetProofsRequest")
+	proto.RegisterType((*GetProofsResponse)(nil), "da.GetProofsResponse")
+	proto.RegisterType((*CommitRequest)(nil), "da.CommitRequest")
+	proto.RegisterType((*CommitResponse)(nil), "da.CommitResponse")
+	proto.RegisterType((*SubmitRequest)(nil), "da.SubmitRequest")
+	proto.RegisterType((*SubmitResponse)(nil), "da.SubmitResponse")
+	proto.RegisterType((*ValidateRequest)(nil), "da.ValidateRequest")
+	proto.RegisterType((*ValidateResponse)(nil), "da.ValidateResponse")
+	proto.RegisterType((*SubscribeRequest)(nil), "da.SubscribeRequest")
+	proto.RegisterType((*SubscribeResponse)(nil), "da.SubscribeResponse")
+	proto.RegisterType((*LatestHeightRequest)(nil), "da.LatestHeightRequest")
+	proto.RegisterType((*LatestHeightResponse)(nil), "da.LatestHeightResponse")
+	proto.RegisterType((*GetAllRequest)(nil), "da.GetAllRequest")
+	proto.RegisterType((*GetAllResponse)(nil), "da.GetAllResponse")
+	proto.RegisterType((*SubmitWithReceiptResponse)(nil), "da.SubmitWithReceiptResponse")
+	proto.RegisterType((*GetSubmissionStatusRequest)(nil), "da.GetSubmissionStatusRequest")
+	proto.RegisterType((*GetSubmissionStatusResponse)(nil), "da.GetSubmissionStatusResponse")
+	proto.RegisterType((*GasPriceRequest)(nil), "da.GasPriceRequest")
+	proto.RegisterType((*GasPriceResponse)(nil), "da.GasPriceResponse")
+	proto.RegisterType((*GasMultiplierRequest)(nil), "da.GasMultiplierRequest")
+	proto.RegisterType((*GasMultiplierResponse)(nil), "da.GasMultiplierResponse")
+	proto.RegisterType((*CapabilitiesRequest)(nil), "da.CapabilitiesRequest")
+	proto.RegisterType((*CapabilitiesResponse)(nil), "da.CapabilitiesResponse")
+	proto.RegisterType((*ErrorDetails)(nil), "da.ErrorDetails")
+}
+
+func init() { proto.RegisterFile("da/da.proto", fileDescriptor_feb508392bc12c0f) }
+
+var fileDescriptor_feb508392bc12c0f = []byte{
+	// 1486 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x56, 0x5d, 0x6f, 0xdb, 0xe4,
+	0x17, 0xaf, 0x93, 0xbe, 0xf9, 0xa4, 0x69, 0xdd, 0xa7, 0x69, 0x97, 0xba, 0x6d, 0xda, 0x59, 0xda,
+	0x5f, 0xfd, 0x0f, 0x48, 0xb7, 0x22, 0xc1, 0x40, 0x08, 0x48, 0x6d, 0x37, 0xb3, 0x94, 0xd8, 0xe1,
+	0xb1, 0x33, 0x36, 0x84, 0x64, 0x39, 0xcd, 0xb3, 0xd6, 0xc2, 0xa9, 0x43, 0xec, 0x4c, 0xdb, 0xb8,
+	0x40, 0x83, 0xf1, 0x2e, 0xa4, 0x49, 0x7c, 0x05, 0x3e, 0x00, 0x1f, 0x83, 0xcb, 0x5d, 0x72, 0x89,
+	0xb6, 0x6f, 0x91, 0x2b, 0xe4, 0xd7, 0xd8, 0x79, 0x59, 0x29, 0xda, 0xa5, 0x7f, 0xe7, 0xed, 0xe7,
+	0x73, 0xce, 0x73, 0xce, 0x81, 0x5c, 0xdb, 0x38, 0x68, 0x1b, 0xe5, 0x6e, 0xcf, 0x76, 0x6d, 0x94,
+	0x69, 0x1b, 0x6c, 0xe9, 0xd4, 0xb6, 0x4f, 0x2d, 0x72, 0xe0, 0x23, 0xad, 0xfe, 0xfd, 0x83, 0x76,
+	0xbf, 0x67, 0xb8, 0xa6, 0x7d, 0x1e, 0xe8, 0xb0, 0xbb, 0xa3, 0x72, 0xd7, 0xec, 0x10, 0xc7, 0x35,
+	0x3a, 0xdd, 0x40, 0x81, 0xbb, 0x0a, 0xb4, 0x6c, 0x74, 0x88, 0xd3, 0x35, 0x4e, 0x08, 0x2a, 0xc0,
+	0xdc, 0x03, 0xc3, 0xea, 0x93, 0x22, 0xb5, 0x47, 0xed, 0x2f, 0xe1, 0xe0, 0x83, 0xdb, 0x86, 0xd9,
+	0x23, 0xcb, 0x6e, 0x4d, 0x91, 0xb2, 0x90, 0x91, 0x84, 0x29, 0x32, 0x0e, 0x80, 0xb7, 0x3b, 0x1d,
+	0xd3, 0xed, 0x90, 0x73, 0x77, 0x8a, 0xce, 0x0e, 0xcc, 0x35, 0x7a, 0xb6, 0x7d, 0x7f, 0x8a, 0x78,
+	0x17, 0x16, 0x30, 0x39, 0x21, 0x66, 0x77, 0x9a, 0x7d, 0x01, 0x50, 0xdd, 0x78, 0xe8, 0x11, 0x54,
+	0xcd, 0xc7, 0x04, 0x93, 0x2f, 0xfb, 0xc4, 0x71, 0xb9, 0xf7, 0x60, 0x2d, 0x85, 0x3a, 0x5d, 0xfb,
+	0xdc, 0x21, 0x88, 0x83, 0x7c, 0xc7, 0x78, 0xa8, 0xb7, 0x2c, 0xbb, 0xa5, 0x3b, 0xe6, 0xe3, 0xc0,
+	0xd5, 0x2c, 0xce, 0x75, 0x86, 0xba, 0x9c, 0x0a, 0x50, 0x25, 0x6e, 0xe8, 0x08, 0x15, 0x21, 0x6b,
+	0xb6, 0x9d, 0x22, 0xb5, 0x97, 0xdd, 0xcf, 0x1d, 0xce, 0x97, 0xdb, 0x46, 0x59, 0x12, 0xb0, 0x07,
+	0xa1, 0x37, 0x80, 0x3e, 0x8f, 0x32, 0x57, 0xcc, 0xec, 0x51, 0xfb, 0xb9, 0xc3, 0xbc, 0x27, 0x8f,
+	0xd3, 0x89, 0x87, 0x72, 0xee, 0x2d, 0xc8, 0xf9, 0x4e, 0x43, 0x1e, 0x25, 0x98, 0xf3, 0x38, 0x44,
+	0x7e, 0x17, 0x3d, 0x3b, 0x8f, 0x00, 0x0e, 0x60, 0x4e, 0x83, 0x7c, 0x95, 0xb8, 0x52, 0xdb, 0x89,
+	0x68, 0x6c, 0xc0, 0xfc, 0x19, 0x31, 0x4f, 0xcf, 0xdc, 0x90, 0x71, 0xf8, 0x75, 0x39, 0x12, 0x6d,
+	0x58, 0x8e, 0xbc, 0x86, 0x3c, 0xa6, 0xff, 0xdd, 0x2d, 0xa0, 0xe3, 0x56, 0x09, 0x1d, 0xb3, 0xe5,
+	0xa0, 0x99, 0xca, 0x51, 0x33, 0x95, 0xb5, 0x48, 0x03, 0x0f, 0x95, 0xb9, 0x7b, 0xc0, 0x54, 0x89,
+	0xeb, 0xd7, 0xd4, 0x79, 0xcd, 0x59, 0x7c, 0x07, 0x56, 0x13, 0xae, 0xc3, 0x7f, 0xb8, 0x0a, 0xf3,
+	0x5d, 0x1f, 0x09, 0xdd, 0xd3, 0x9e, 0xb9, 0xaf, 0x83, 0x43, 0x01, 0xf7, 0x39, 0xe4, 0x83, 0x3e,
+	0x8c, 0xf8, 0x5c, 0x90, 0xff, 0xcb, 0xb1, 0x3a, 0x82, 0xe5, 0xc8, 0x7b, 0x48, 0xe9, 0x06, 0xe4,
+	0x4e, 0xe2, 0xbe, 0x8f, 0x82, 0x2c, 0x7b, 0x0e, 0x86, 0xcf, 0x01, 0x27, 0x55, 0xb8, 0xdf, 0x28,
+	0xc8, 0xab, 0xfd, 0xd6, 0x25, 0x28, 0x6e, 0x01, 0x7d, 0x6a, 0x38, 0x7a, 0xb7, 0x67, 0x86, 0x14,
+	0x29, 0xbc, 0x78, 0x6a, 0x38, 0x0d, 0xef, 0x3b, 0xcd, 0x3f, 0xfb, 0x6a, 0xfe, 0xa8, 0x08, 0x0b,
+	0x76, 0xd7, 0x9b, 0x19, 0x4e, 0x71, 0xd6, 0x7f, 0x59, 0xd1, 0x27, 0x77, 0x1d, 0x96, 0x23, 0x52,
+	0x17, 0x35, 0x0c, 0xf7, 0x15, 0xac, 0xdc, 0x31, 0x2c, 0xb3, 0x6d, 0xb8, 0xe4, 0xe2, 0xaa, 0x0f,
+	0x6b, 0x96, 0x99, 0x52, 0xb3, 0x4b, 0xfd, 0x02, 0xf7, 0x26, 0x30, 0xc3, 0xe0, 0x31, 0xd5, 0x85,
+	0x1e, 0x71, 0xfa, 0x56, 0x58, 0x80, 0x45, 0x1c, 0x7d, 0x72, 0x1f, 0x01, 0xa3, 0xf6, 0x5b, 0xce,
+	0x49, 0xcf, 0x6c, 0xc5, 0x5c, 0x53, 0xe1, 0xa8, 0x0b, 0xc2, 0x7d, 0x0d, 0xab, 0x09, 0x07, 0x61,
+	0xbc, 0x69, 0x4f, 0x34, 0xcc, 0x42, 0xe6, 0x82, 0x37, 0x96, 0xbd, 0xcc, 0x1b, 0x5b, 0x87, 0xb5,
+	0x9a, 0xe1, 0x12, 0xc7, 0xbd, 0xed, 0xc7, 0x88, 0xa6, 0x5e, 0x19, 0x0a, 0x69, 0xf8, 0xd5, 0xd4,
+	0xc2, 0x31, 0x53, 0xb1, 0xac, 0xd7, 0x3a, 0x66, 0x9e, 0x52, 0xb0, 0x1c, 0xb9, 0xbd, 0x70, 0xce,
+	0xc4, 0x6d, 0x9e, 0x99, 0xdc, 0xe6, 0xff, 0x3d, 0x47, 0x47, 0xb0, 0x19, 0x34, 0xef, 0xa7, 0xa6,
+	0x7b, 0x16, 0xee, 0x90, 0x98, 0xd0, 0x35, 0xaf, 0x39, 0x7c, 0x28, 0x2c, 0x76, 0xce, 0x0b, 0x1c,
+	0x69, 0x45, 0x32, 0x8e, 0x07, 0xb6, 0x4a, 0x5c, 0xdf, 0x8d, 0xe3, 0x98, 0xf6, 0xb9, 0xea, 0x1a,
+	0x6e, 0x3f, 0x9e, 0x6a, 0xff, 0xd2, 0xc9, 0x33, 0x0a, 0xb6, 0x26, 0x7a, 0x09, 0xb9, 0xfc, 0x1f,
+	0xe6, 0x1c, 0xd7, 0x70, 0x83, 0xb6, 0x5b, 0x3e, 0x5c, 0xf3, 0x9c, 0xa4, 0x95, 0x09, 0x0e, 0x34,
+	0x12, 0xf5, 0xc9, 0x4c, 0xea, 0xb1, 0xec, 0x78, 0x7e, 0x0b, 0x30, 0x47, 0x7a, 0x3d, 0xbb, 0xe7,
+	0x3f, 0x6d, 0x1a, 0x07, 0x1f, 0xdc, 0x2a, 0xac, 0x54, 0xc3, 0x59, 0x11, 0xf5, 0xce, 0x01, 0x30,
+	0x43, 0x28, 0x64, 0x96, 0x9a, 0x31, 0x54, 0x7a, 0xc6, 0x70, 0x1b, 0x50, 0xa8, 0x1a, 0x4e, 0xbd,
+	0x6f, 0xb9, 0x66, 0xd7, 0x32, 0x49, 0x2f, 0x72, 0xf4, 0x21, 0xac, 0x8f, 0xe0, 0x71, 0xce, 0x97,
+	0x3d, 0x6f, 0x9d, 0x58, 0x12, 0xba, 0xcc, 0x9f, 0x26, 0xd5, 0xbd, 0xde, 0xe6, 0x8d, 0xae, 0xd1,
+	0x32, 0x2d, 0xd3, 0x35, 0x49, 0x94, 0x6c, 0xee, 0x49, 0x06, 0x0a, 0x69, 0x7c, 0xe8, 0x36, 0xee,
+	0xbd, 0xe1, 0x52, 0xcf, 0xe3, 0x7c, 0x8c, 0x7a, 0x6b, 0xdd, 0x53, 0x0b, 0xc7, 0x9a, 0xee, 0x9c,
+	0x9c, 0x91, 0x8e, 0xe1, 0xa7, 0x90, 0xc6, 0xf9, 0x10, 0x55, 0x7d, 0x10, 0xed, 0x00, 0xf8, 0x03,
+	0x48, 0x77, 0x1f, 0x75, 0x83, 0xb9, 0x43, 0x63, 0xda, 0x47, 0xb4, 0x47, 0xdd, 0x09, 0x07, 0xc4,
+	0xec, 0xd8, 0x01, 0x81, 0x6e, 0x01, 0xb4, 0x2c, 0xfb, 0xe4, 0x0b, 0xdd, 0xeb, 0xc5, 0xe2, 0x9c,
+	0xdf, 0x19, 0x9b, 0x63, 0x3d, 0x2b, 0x84, 0x87, 0x1a, 0xa6, 0x7d, 0x65, 0xaf, 0x85, 0x51, 0x09,
+	0x80, 0x3c, 0x74, 0xc9, 0xb9, 0xe3, 0x0f, 0xe3, 0xf9, 0xbd, 0xec, 0x3e, 0x8d, 0x13, 0x08, 0x77,
+	0x13, 0x96, 0x44, 0xaf, 0x7e, 0x02, 0x71, 0x0d, 0xd3, 0xf2, 0xc6, 0xe8, 0xec, 0x89, 0xdd, 0x8e,
+	0x1a, 0xc7, 0x7f, 0x91, 0xbe, 0x9c, 0xb7, 0xdb, 0x04, 0xfb, 0xa2, 0xeb, 0xbf, 0x52, 0xb0, 0x32,
+	0xd2, 0x4c, 0x68, 0x0f, 0xb6, 0xd5, 0xe6, 0x51, 0x5d, 0x52, 0x55, 0x49, 0x91, 0x75, 0x55, 0xab,
+	0x68, 0xa2, 0xde, 0x94, 0xd5, 0x86, 0xc8, 0x4b, 0xc7, 0x92, 0x28, 0x30, 0x33, 0x68, 0x1b, 0x8a,
+	0x63, 0x1a, 0x0d, 0x51, 0x16, 0x24, 0xb9, 0xca, 0x50, 0x68, 0x07, 0x36, 0xc7, 0xa4, 0x92, 0xcc,
+	0xd7, 0x9a, 0x82, 0x28, 0x30, 0x19, 0xb4, 0x05, 0x57, 0xc6, 0xc4, 0xc7, 0x15, 0xa9, 0x26, 0x0a,
+	0x4c, 0xf6, 0xfa, 0x1f, 0x59, 0xa0, 0x63, 0x8e, 0x88, 0x85, 0x0d, 0x11, 0x63, 0x05, 0xeb, 0xbc,
+	0x22, 0x8c, 0x72, 0xd8, 0x85, 0xcd, 0x84, 0xec, 0xa8, 0xa6, 0x1c, 0xe9, 0xb2, 0xa2, 0xe9, 0xc7,
+	0x4a, 0x53, 0x16, 0x98, 0x27, 0x03, 0x0a, 0x5d, 0x83, 0xdd, 0x51, 0x05, 0x55, 0xfa, 0x4c, 0xd4,
+	0x95, 0x3b, 0x22, 0xd6, 0x6b, 0x52, 0x5d, 0xd2, 0x98, 0x6f, 0x06, 0x1e, 0xdb, 0x2b, 0x09, 0x35,
+	0xed, 0xae, 0xae, 0x49, 0x75, 0x51, 0xd0, 0x95, 0xa6, 0xc6, 0x7c, 0x3b, 0xa0, 0xd0, 0xff, 0x60,
+	0x2f, 0x2d, 0xae, 0xd4, 0xb0, 0x58, 0x11, 0xee, 0xe9, 0x92, 0xac, 0xd7, 0xc5, 0x7a, 0x43, 0x51,
+	0x6a, 0xcc, 0xd3, 0x01, 0x85, 0xca, 0xb0, 0x9f, 0xd6, 0x93, 0x64, 0x5e, 0xc1, 0x58, 0xe4, 0x35,
+	0xbd, 0xc2, 0xf3, 0x4a, 0x53, 0xd6, 0x74, 0x55, 0xfc, 0xa4, 0x29, 0xca, 0xbc, 0xc8, 0x7c, 0x37,
+	0x31, 0xac, 0xa2, 0xe8, 0xb5, 0x0a, 0xae, 0x8a, 0xcc, 0xf7, 0x03, 0x0a, 0x5d, 0x85, 0xad, 0x84,
+	0x98, 0x57, 0x64, 0x4d, 0xbc, 0xab, 0xe9, 0x82, 0x58, 0x11, 0x6a, 0x92, 0x2c, 0x32, 0x3f, 0x0c,
+	0x28, 0x54, 0x82, 0x62, 0x42, 0xe5, 0xb8, 0xa9, 0x35, 0xb1, 0xa8, 0xdf, 0x16, 0xa5, 0xea, 0x6d,
+	0x8d, 0xf9, 0x71, 0x40, 0x21, 0x0e, 0xb6, 0x13, 0x72, 0x2c, 0xf2, 0xa2, 0xd4, 0xd0, 0x12, 0x39,
+	0xfa, 0x69, 0x4c, 0x47, 0x92, 0xef, 0x54, 0x6a, 0x92, 0xa0, 0xcb, 0x95, 0xba, 0xa8, 0x36, 0x2a,
+	0xbc, 0xc8, 0xfc, 0x3c, 0x16, 0xc7, 0xb3, 0x57, 0x9b, 0x8d, 0x86, 0x82, 0x35, 0x51, 0x60, 0x7e,
+	0x19, 0x50, 0x87, 0xbf, 0x2f, 0x00, 0x2d, 0x54, 0x54, 0xd2, 0x7b, 0xe0, 0x9d, 0x16, 0x1f, 0x43,
+	0x2e, 0x71, 0x59, 0xa3, 0x0d, 0xaf, 0xe9, 0xc6, 0x0f, 0x70, 0xf6, 0xca, 0x18, 0x1e, 0x3c, 0x57,
+	0x6e, 0x06, 0xed, 0x43, 0xb6, 0x4a, 0x5c, 0xe4, 0xdf, 0x43, 0xc3, 0x4b, 0x9b, 0x5d, 0x89, 0xbf,
+	0x63, 0xcd, 0x9b, 0x30, 0x1f, 0x1c, 0xac, 0x68, 0x35, 0x14, 0x0e, 0x4f, 0x62, 0x16, 0x25, 0xa1,
+	0xd8, 0xe4, 0x7d, 0xa0, 0xe3, 0x13, 0x11, 0x15, 0x42, 0x95, 0xd4, 0x31, 0xca, 0xae, 0x8f, 0xa0,
+	0xc9, 0x70, 0xc1, 0x7d, 0x16, 0x84, 0x4b, 0x9d, 0x8c, 0x2c, 0x4a, 0x42, 0x49, 0x93, 0x60, 0xc9,
+	0x04, 0x26, 0xa9, 0x13, 0x8e, 0x45, 0x49, 0x28, 0x36, 0x79, 0x17, 0x16, 0xa3, 0x5b, 0x05, 0xf9,
+	0xb3, 0x7e, 0xe4, 0x6c, 0x62, 0x0b, 0x69, 0x30, 0x36, 0xfc, 0x00, 0xe8, 0xf8, 0xea, 0x08, 0x7e,
+	0x6d, 0xf4, 0x8a, 0x61, 0xd7, 0x47, 0xd0, 0xc8, 0xf6, 0x06, 0x85, 0x78, 0x58, 0x4a, 0xde, 0x06,
+	0xc8, 0x2f, 0xd0, 0x84, 0x23, 0x82, 0x2d, 0x8e, 0x0b, 0x46, 0x0a, 0x52, 0xb1, 0xac, 0xb8, 0x20,
+	0xc3, 0xe3, 0x81, 0x45, 0x49, 0x28, 0x36, 0xa9, 0xc2, 0xea, 0xd8, 0x1a, 0x9e, 0x94, 0xac, 0x9d,
+	0x21, 0x34, 0x61, 0x61, 0x73, 0x33, 0xe8, 0x2e, 0xac, 0x4d, 0xd8, 0xa2, 0xa8, 0x14, 0x46, 0x9d,
+	0xb2, 0xa4, 0xd9, 0xdd, 0xa9, 0xf2, 0x64, 0x45, 0xa2, 0xd5, 0x17, 0x54, 0x64, 0x64, 0x37, 0xb2,
+	0x85, 0x34, 0x18, 0x1b, 0x1e, 0x43, 0x3e, 0xb5, 0xea, 0x50, 0x31, 0x54, 0x1c, 0xdb, 0x8a, 0xec,
+	0xe6, 0x04, 0x49, 0xec, 0x87, 0x87, 0xa5, 0xe4, 0x6a, 0x0b, 0x6a, 0x33, 0x61, 0x09, 0xb2, 0xc5,
+	0x71, 0x41, 0xe4, 0xe4, 0xa8, 0xf8, 0xe7, 0x8b, 0x12, 0xf5, 0xfc, 0x45, 0x89, 0xfa, 0xfb, 0x45,
+	0x89, 0x7a, 0xf6, 0xb2, 0x34, 0xf3, 0xfc, 0x65, 0x69, 0xe6, 0xaf, 0x97, 0xa5, 0x99, 0xd6, 0xbc,
+	0xbf, 0x74, 0xde, 0xfe, 0x67, 0x00, 0x65, 0x9e, 0x23, 0x63, 0x3e, 0x10, 0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// DAServiceClient is the client API for DAService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type DAServiceClient interface {
+	// MaxBlobSize returns the maximum blob size
+	MaxBlobSize(ctx context.Context, in *MaxBlobSizeRequest, opts ...grpc.CallOption) (*MaxBlobSizeResponse, error)
+	// Get returns Blob for each given ID, or an error.
+	Get(ctx context.Context, in *GetRequest, opts ...grpc.CallOption) (*GetResponse, error)
+	// GetIds returns IDs of all Blobs located in DA at given height.
+	GetIds(ctx context.Context, in *GetIdsRequest, opts ...grpc.CallOption) (*GetIdsResponse, error)
+	// GetProofs returns inclusion Proofs for all Blobs located in DA at given height.
+	GetProofs(ctx context.Context, in *GetProofsRequest, opts ...grpc.CallOption) (*GetProofsResponse, error)
+	// Commit creates a Commitment for each given Blob.
+	Commit(ctx context.Context, in *CommitRequest, opts ...grpc.CallOption) (*CommitResponse, error)
+	// Submit submits the given Blobs to Data Availability layer.
+	Submit(ctx context.Context, in *SubmitRequest, opts ...grpc.CallOption) (*SubmitResponse, error)
+	// Validate validates Commitments against corresponding Proofs. This should be possible without retrieving Blob.
+	Validate(ctx context.Context, in *ValidateRequest, opts ...grpc.CallOption) (*ValidateResponse, error)
+	// Subscribe streams new heights with IDs of all Blobs located in DA at each height.
+	Subscribe(ctx context.Context, in *SubscribeRequest, opts ...grpc.CallOption) (DAService_SubscribeClient, error)
+	// LatestHeight returns the height of the latest block available in DA.
+	LatestHeight(ctx context.Context, in *LatestHeightRequest, opts ...grpc.CallOption) (*LatestHeightResponse, error)
+	// GetAll returns IDs and Blobs of all Blobs located in DA at given height.
+	GetAll(ctx context.Context, in *GetAllRequest, opts ...grpc.CallOption) (*GetAllResponse, error)
+	// SubmitWithReceipt submits the given Blobs to Data Availability layer, without waiting for inclusion.
+	SubmitWithReceipt(ctx context.Context, in *SubmitRequest, opts ...grpc.CallOption) (*SubmitWithReceiptResponse, error)
+	// GetSubmissionStatus returns the status of submission identified by Receipt.
+	GetSubmissionStatus(ctx context.Context, in *GetSubmissionStatusRequest, opts ...grpc.CallOption) (*GetSubmissionStatusResponse, error)
+	// GasPrice returns the gas price estimated by DA.
+	GasPrice(ctx context.Context, in *GasPriceRequest, opts ...grpc.CallOption) (*GasPriceResponse, error)
+	// GasMultiplier returns the multiplier applied to gas price on resubmission.
+	GasMultiplier(ctx context.Context, in *GasMultiplierRequest, opts ...grpc.CallOption) (*GasMultiplierResponse, error)
+	// Capabilities returns the set of features supported by DA.
+	Capabilities(ctx context.Context, in *CapabilitiesRequest, opts ...grpc.CallOption) (*CapabilitiesResponse, error)
+}
+
+type dAServiceClient struct {
+	cc grpc1.ClientConn
+}
+
+func NewDAServiceClient(cc grpc1.ClientConn) DAServiceClient {
+	return &dAServiceClient{cc}
+}
+
+func (c *dAServiceClient) MaxBlobSize(ctx context.Context, in *MaxBlobSizeRequest, opts ...grpc.CallOption) (*MaxBlobSizeResponse, error) {
+	out := new(MaxBlobSizeResponse)
+	err := c.cc.Invoke(ctx, "/da.DAService/MaxBlobSize", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *CommitResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *dAServiceClient) Get(ctx context.Context, in *GetRequest, opts ...grpc.CallOption) (*GetResponse, error) {
+	out := new(GetResponse)
+	err := c.cc.Invoke(ctx, "/da.DAService/Get", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *CommitResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Commitments) > 0 {
-		for iNdEx := len(m.Commitments) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Commitments[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintDa(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
-		}
+func (c *dAServiceClient) GetIds(ctx context.Context, in *GetIdsRequest, opts ...grpc.CallOption) (*GetIdsResponse, error) {
+	out := new(GetIdsResponse)
+	err := c.cc.Invoke(ctx, "/da.DAService/GetIds", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *SubmitRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *dAServiceClient) GetProofs(ctx context.Context, in *GetProofsRequest, opts ...grpc.CallOption) (*GetProofsResponse, error) {
+	out := new(GetProofsResponse)
+	err := c.cc.Invoke(ctx, "/da.DAService/GetProofs", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *SubmitRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *dAServiceClient) Commit(ctx context.Context, in *CommitRequest, opts ...grpc.CallOption) (*CommitResponse, error) {
+	out := new(CommitResponse)
+	err := c.cc.Invoke(ctx, "/da.DAService/Commit", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *SubmitRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Options) > 0 {
-		i -= len(m.Options)
-		copy(dAtA[i:], m.Options)
-		i = encodeVarintDa(dAtA, i, uint64(len(m.Options)))
-		i--
-		dAtA[i] = 0x22
-	}
-	if m.Namespace != nil {
-		{
-			size, err := m.Namespace.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintDa(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x1a
-	}
-	if m.GasPrice != 0 {
-		i -= 8
-		encoding_binary.LittleEndian.PutUint64(dAtA[i:], uint64(math.Float64bits(float64(m.GasPrice))))
-		i--
-		dAtA[i] = 0x11
+func (c *dAServiceClient) Submit(ctx context.Context, in *SubmitRequest, opts ...grpc.CallOption) (*SubmitResponse, error) {
+	out := new(SubmitResponse)
+	err := c.cc.Invoke(ctx, "/da.DAService/Submit", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	if len(m.Blobs) > 0 {
-		for iNdEx := len(m.Blobs) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Blobs[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintDa(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
-		}
+	return out, nil
+}
+
+func (c *dAServiceClient) Validate(ctx context.Context, in *ValidateRequest, opts ...grpc.CallOption) (*ValidateResponse, error) {
+	out := new(ValidateResponse)
+	err := c.cc.Invoke(ctx, "/da.DAService/Validate", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *SubmitResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *dAServiceClient) Subscribe(ctx context.Context, in *SubscribeRequest, opts ...grpc.CallOption) (DAService_SubscribeClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_DAService_serviceDesc.Streams[0], "/da.DAService/Subscribe", opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	x := &dAServiceSubscribeClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
 }
 
-func (m *SubmitResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type DAService_SubscribeClient interface {
+	Recv() (*SubscribeResponse, error)
+	grpc.ClientStream
 }
 
-func (m *SubmitResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Ids) > 0 {
-		for iNdEx := len(m.Ids) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Ids[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintDa(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
-		}
+type dAServiceSubscribeClient struct {
+	grpc.ClientStream
+}
+
+func (x *dAServiceSubscribeClient) Recv() (*SubscribeResponse, error) {
+	m := new(SubscribeResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	return m, nil
 }
 
-func (m *ValidateRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *dAServiceClient) LatestHeight(ctx context.Context, in *LatestHeightRequest, opts ...grpc.CallOption) (*LatestHeightResponse, error) {
+	out := new(LatestHeightResponse)
+	err := c.cc.Invoke(ctx, "/da.DAService/LatestHeight", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *ValidateRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *dAServiceClient) GetAll(ctx context.Context, in *GetAllRequest, opts ...grpc.CallOption) (*GetAllResponse, error) {
+	out := new(GetAllResponse)
+	err := c.cc.Invoke(ctx, "/da.DAService/GetAll", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *ValidateRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Namespace != nil {
-		{
-			size, err := m.Namespace.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintDa(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x1a
-	}
-	if len(m.Proofs) > 0 {
-		for iNdEx := len(m.Proofs) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Proofs[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintDa(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x12
-		}
-	}
-	if len(m.Ids) > 0 {
-		for iNdEx := len(m.Ids) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Ids[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintDa(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
-		}
+func (c *dAServiceClient) SubmitWithReceipt(ctx context.Context, in *SubmitRequest, opts ...grpc.CallOption) (*SubmitWithReceiptResponse, error) {
+	out := new(SubmitWithReceiptResponse)
+	err := c.cc.Invoke(ctx, "/da.DAService/SubmitWithReceipt", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *ValidateResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *dAServiceClient) GetSubmissionStatus(ctx context.Context, in *GetSubmissionStatusRequest, opts ...grpc.CallOption) (*GetSubmissionStatusResponse, error) {
+	out := new(GetSubmissionStatusResponse)
+	err := c.cc.Invoke(ctx, "/da.DAService/GetSubmissionStatus", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *ValidateResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *dAServiceClient) GasPrice(ctx context.Context, in *GasPriceRequest, opts ...grpc.CallOption) (*GasPriceResponse, error) {
+	out := new(GasPriceResponse)
+	err := c.cc.Invoke(ctx, "/da.DAService/GasPrice", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *ValidateResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Results) > 0 {
-		for iNdEx := len(m.Results) - 1; iNdEx >= 0; iNdEx-- {
-			i--
-			if m.Results[iNdEx] {
-				dAtA[i] = 1
-			} else {
-				dAtA[i] = 0
-			}
-		}
-		i = encodeVarintDa(dAtA, i, uint64(len(m.Results)))
-		i--
-		dAtA[i] = 0xa
+func (c *dAServiceClient) GasMultiplier(ctx context.Context, in *GasMultiplierRequest, opts ...grpc.CallOption) (*GasMultiplierResponse, error) {
+	out := new(GasMultiplierResponse)
+	err := c.cc.Invoke(ctx, "/da.DAService/GasMultiplier", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *ErrorDetails) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *dAServiceClient) Capabilities(ctx context.Context, in *CapabilitiesRequest, opts ...grpc.CallOption) (*CapabilitiesResponse, error) {
+	out := new(CapabilitiesResponse)
+	err := c.cc.Invoke(ctx, "/da.DAService/Capabilities", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *ErrorDetails) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// DAServiceServer is the server API for DAService service.
+type DAServiceServer interface {
+	// MaxBlobSize returns the maximum blob size
+	MaxBlobSize(context.Context, *MaxBlobSizeRequest) (*MaxBlobSizeResponse, error)
+	// Get returns Blob for each given ID, or an error.
+	Get(context.Context, *GetRequest) (*GetResponse, error)
+	// GetIds returns IDs of all Blobs located in DA at given height.
+	GetIds(context.Context, *GetIdsRequest) (*GetIdsResponse, error)
+	// GetProofs returns inclusion Proofs for all Blobs located in DA at given height.
+	GetProofs(context.Context, *GetProofsRequest) (*GetProofsResponse, error)
+	// Commit creates a Commitment for each given Blob.
+	Commit(context.Context, *CommitRequest) (*CommitResponse, error)
+	// Submit submits the given Blobs to Data Availability layer.
+	Submit(context.Context, *SubmitRequest) (*SubmitResponse, error)
+	// Validate validates Commitments against corresponding Proofs. This should be possible without retrieving Blob.
+	Validate(context.Context, *ValidateRequest) (*ValidateResponse, error)
+	// Subscribe streams new heights with IDs of all Blobs located in DA at each height.
+	Subscribe(*SubscribeRequest, DAService_SubscribeServer) error
+	// LatestHeight returns the height of the latest block available in DA.
+	LatestHeight(context.Context, *LatestHeightRequest) (*LatestHeightResponse, error)
+	// GetAll returns IDs and Blobs of all Blobs located in DA at given height.
+	GetAll(context.Context, *GetAllRequest) (*GetAllResponse, error)
+	// SubmitWithReceipt submits the given Blobs to Data Availability layer, without waiting for inclusion.
+	SubmitWithReceipt(context.Context, *SubmitRequest) (*SubmitWithReceiptResponse, error)
+	// GetSubmissionStatus returns the status of submission identified by Receipt.
+	GetSubmissionStatus(context.Context, *GetSubmissionStatusRequest) (*GetSubmissionStatusResponse, error)
+	// GasPrice returns the gas price estimated by DA.
+	GasPrice(context.Context, *GasPriceRequest) (*GasPriceResponse, error)
+	// GasMultiplier returns the multiplier applied to gas price on resubmission.
+	GasMultiplier(context.Context, *GasMultiplierRequest) (*GasMultiplierResponse, error)
+	// Capabilities returns the set of features supported by DA.
+	Capabilities(context.Context, *CapabilitiesRequest) (*CapabilitiesResponse, error)
 }
 
-func (m *ErrorDetails) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Code != 0 {
-		i = encodeVarintDa(dAtA, i, uint64(m.Code))
-		i--
-		dAtA[i] = 0x8
-	}
-	return len(dAtA) - i, nil
+// UnimplementedDAServiceServer can be embedded to have forward compatible implementations.
+type UnimplementedDAServiceServer struct {
 }
 
-func encodeVarintDa(dAtA []byte, offset int, v uint64) int {
-	offset -= sovDa(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
-	}
-	dAtA[offset] = uint8(v)
-	return base
+func (*UnimplementedDAServiceServer) MaxBlobSize(ctx context.Context, req *MaxBlobSizeRequest) (*MaxBlobSizeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MaxBlobSize not implemented")
 }
-func (m *Namespace) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Value)
-	if l > 0 {
-		n += 1 + l + sovDa(uint64(l))
-	}
-	return n
+func (*UnimplementedDAServiceServer) Get(ctx context.Context, req *GetRequest) (*GetResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Get not implemented")
+}
+func (*UnimplementedDAServiceServer) GetIds(ctx context.Context, req *GetIdsRequest) (*GetIdsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetIds not implemented")
+}
+func (*UnimplementedDAServiceServer) GetProofs(ctx context.Context, req *GetProofsRequest) (*GetProofsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetProofs not implemented")
+}
+func (*UnimplementedDAServiceServer) Commit(ctx context.Context, req *CommitRequest) (*CommitResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Commit not implemented")
+}
+func (*UnimplementedDAServiceServer) Submit(ctx context.Context, req *SubmitRequest) (*SubmitResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Submit not implemented")
+}
+func (*UnimplementedDAServiceServer) Validate(ctx context.Context, req *ValidateRequest) (*ValidateResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Validate not implemented")
+}
+func (*UnimplementedDAServiceServer) Subscribe(req *SubscribeRequest, srv DAService_SubscribeServer) error {
+	return status.Errorf(codes.Unimplemented, "method Subscribe not implemented")
+}
+func (*UnimplementedDAServiceServer) LatestHeight(ctx context.Context, req *LatestHeightRequest) (*LatestHeightResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method LatestHeight not implemented")
+}
+func (*UnimplementedDAServiceServer) GetAll(ctx context.Context, req *GetAllRequest) (*GetAllResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetAll not implemented")
+}
+func (*UnimplementedDAServiceServer) SubmitWithReceipt(ctx context.Context, req *SubmitRequest) (*SubmitWithReceiptResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SubmitWithReceipt not implemented")
+}
+func (*UnimplementedDAServiceServer) GetSubmissionStatus(ctx context.Context, req *GetSubmissionStatusRequest) (*GetSubmissionStatusResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetSubmissionStatus not implemented")
+}
+func (*UnimplementedDAServiceServer) GasPrice(ctx context.Context, req *GasPriceRequest) (*GasPriceResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GasPrice not implemented")
+}
+func (*UnimplementedDAServiceServer) GasMultiplier(ctx context.Context, req *GasMultiplierRequest) (*GasMultiplierResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GasMultiplier not implemented")
+}
+func (*UnimplementedDAServiceServer) Capabilities(ctx context.Context, req *CapabilitiesRequest) (*CapabilitiesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Capabilities not implemented")
 }
 
-func (m *Blob) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Value)
-	if l > 0 {
-		n += 1 + l + sovDa(uint64(l))
-	}
-	return n
+func RegisterDAServiceServer(s grpc1.Server, srv DAServiceServer) {
+	s.RegisterService(&_DAService_serviceDesc, srv)
 }
 
-func (m *ID) Size() (n int) {
-	if m == nil {
-		return 0
+func _DAService_MaxBlobSize_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MaxBlobSizeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = len(m.Value)
-	if l > 0 {
-		n += 1 + l + sovDa(uint64(l))
+	if interceptor == nil {
+		return srv.(DAServiceServer).MaxBlobSize(ctx, in)
 	}
-	return n
-}
-
-func (m *Commitment) Size() (n int) {
-	if m == nil {
-		return 0
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/da.DAService/MaxBlobSize",
 	}
-	var l int
-	_ = l
-	l = len(m.Value)
-	if l > 0 {
-		n += 1 + l + sovDa(uint64(l))
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DAServiceServer).MaxBlobSize(ctx, req.(*MaxBlobSizeRequest))
 	}
-	return n
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *Proof) Size() (n int) {
-	if m == nil {
-		return 0
+func _DAService_Get_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = len(m.Value)
-	if l > 0 {
-		n += 1 + l + sovDa(uint64(l))
-	}
-	return n
-}
-
-func (m *MaxBlobSizeRequest) Size() (n int) {
-	if m == nil {
-		return 0
+	if interceptor == nil {
+		return srv.(DAServiceServer).Get(ctx, in)
 	}
-	var l int
-	_ = l
-	return n
-}
-
-func (m *MaxBlobSizeResponse) Size() (n int) {
-	if m == nil {
-		return 0
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/da.DAService/Get",
 	}
-	var l int
-	_ = l
-	if m.MaxBlobSize != 0 {
-		n += 1 + sovDa(uint64(m.MaxBlobSize))
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DAServiceServer).Get(ctx, req.(*GetRequest))
 	}
-	return n
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *GetRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func _DAService_GetIds_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetIdsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	if len(m.Ids) > 0 {
-		for _, e := range m.Ids {
-			l = e.Size()
-			n += 1 + l + sovDa(uint64(l))
-		}
+	if interceptor == nil {
+		return srv.(DAServiceServer).GetIds(ctx, in)
 	}
-	if m.Namespace != nil {
-		l = m.Namespace.Size()
-		n += 1 + l + sovDa(uint64(l))
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/da.DAService/GetIds",
 	}
-	return n
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DAServiceServer).GetIds(ctx, req.(*GetIdsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *GetResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func _DAService_GetProofs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetProofsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	if len(m.Blobs) > 0 {
-		for _, e := range m.Blobs {
-			l = e.Size()
-			n += 1 + l + sovDa(uint64(l))
-		}
+	if interceptor == nil {
+		return srv.(DAServiceServer).GetProofs(ctx, in)
 	}
-	return n
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/da.DAService/GetProofs",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DAServiceServer).GetProofs(ctx, req.(*GetProofsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *GetIdsRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func _DAService_Commit_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CommitRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	if m.Height != 0 {
-		n += 1 + sovDa(uint64(m.Height))
+	if interceptor == nil {
+		return srv.(DAServiceServer).Commit(ctx, in)
 	}
-	if m.Namespace != nil {
-		l = m.Namespace.Size()
-		n += 1 + l + sovDa(uint64(l))
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/da.DAService/Commit",
 	}
-	return n
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DAServiceServer).Commit(ctx, req.(*CommitRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *GetIdsResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func _DAService_Submit_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SubmitRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	if len(m.Ids) > 0 {
-		for _, e := range m.Ids {
-			l = e.Size()
-			n += 1 + l + sovDa(uint64(l))
-		}
+	if interceptor == nil {
+		return srv.(DAServiceServer).Submit(ctx, in)
 	}
-	if m.Timestamp != nil {
-		l = m.Timestamp.Size()
-		n += 1 + l + sovDa(uint64(l))
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/da.DAService/Submit",
 	}
-	return n
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DAServiceServer).Submit(ctx, req.(*SubmitRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *GetProofsRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func _DAService_Validate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ValidateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	if len(m.Ids) > 0 {
-		for _, e := range m.Ids {
-			l = e.Size()
-			n += 1 + l + sovDa(uint64(l))
-		}
+	if interceptor == nil {
+		return srv.(DAServiceServer).Validate(ctx, in)
 	}
-	if m.Namespace != nil {
-		l = m.Namespace.Size()
-		n += 1 + l + sovDa(uint64(l))
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/da.DAService/Validate",
 	}
-	return n
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DAServiceServer).Validate(ctx, req.(*ValidateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *GetProofsResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if len(m.Proofs) > 0 {
-		for _, e := range m.Proofs {
-			l = e.Size()
-			n += 1 + l + sovDa(uint64(l))
-		}
+func _DAService_Subscribe_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(SubscribeRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
 	}
-	return n
+	return srv.(DAServiceServer).Subscribe(m, &dAServiceSubscribeServer{stream})
 }
 
-func (m *CommitRequest) Size() (n int) {
-	if m == nil {
-		return 0
+type DAService_SubscribeServer interface {
+	Send(*SubscribeResponse) error
+	grpc.ServerStream
+}
+
+type dAServiceSubscribeServer struct {
+	grpc.ServerStream
+}
+
+func (x *dAServiceSubscribeServer) Send(m *SubscribeResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _DAService_LatestHeight_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(LatestHeightRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	if len(m.Blobs) > 0 {
-		for _, e := range m.Blobs {
-			l = e.Size()
-			n += 1 + l + sovDa(uint64(l))
-		}
+	if interceptor == nil {
+		return srv.(DAServiceServer).LatestHeight(ctx, in)
 	}
-	if m.Namespace != nil {
-		l = m.Namespace.Size()
-		n += 1 + l + sovDa(uint64(l))
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/da.DAService/LatestHeight",
 	}
-	return n
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DAServiceServer).LatestHeight(ctx, req.(*LatestHeightRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *CommitResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func _DAService_GetAll_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetAllRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	if len(m.Commitments) > 0 {
-		for _, e := range m.Commitments {
-			l = e.Size()
-			n += 1 + l + sovDa(uint64(l))
-		}
+	if interceptor == nil {
+		return srv.(DAServiceServer).GetAll(ctx, in)
 	}
-	return n
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/da.DAService/GetAll",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DAServiceServer).GetAll(ctx, req.(*GetAllRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *SubmitRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if len(m.Blobs) > 0 {
-		for _, e := range m.Blobs {
-			l = e.Size()
-			n += 1 + l + sovDa(uint64(l))
-		}
+func _DAService_SubmitWithReceipt_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SubmitRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	if m.GasPrice != 0 {
-		n += 9
+	if interceptor == nil {
+		return srv.(DAServiceServer).SubmitWithReceipt(ctx, in)
 	}
-	if m.Namespace != nil {
-		l = m.Namespace.Size()
-		n += 1 + l + sovDa(uint64(l))
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/da.DAService/SubmitWithReceipt",
 	}
-	l = len(m.Options)
-	if l > 0 {
-		n += 1 + l + sovDa(uint64(l))
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DAServiceServer).SubmitWithReceipt(ctx, req.(*SubmitRequest))
 	}
-	return n
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *SubmitResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func _DAService_GetSubmissionStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetSubmissionStatusRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
+	if interceptor == nil {
+		return srv.(DAServiceServer).GetSubmissionStatus(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/da.DAService/GetSubmissionStatus",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DAServiceServer).GetSubmissionStatus(ctx, req.(*GetSubmissionStatusRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DAService_GasPrice_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GasPriceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DAServiceServer).GasPrice(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/da.DAService/GasPrice",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DAServiceServer).GasPrice(ctx, req.(*GasPriceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DAService_GasMultiplier_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GasMultiplierRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DAServiceServer).GasMultiplier(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/da.DAService/GasMultiplier",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DAServiceServer).GasMultiplier(ctx, req.(*GasMultiplierRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DAService_Capabilities_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CapabilitiesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DAServiceServer).Capabilities(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/da.DAService/Capabilities",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DAServiceServer).Capabilities(ctx, req.(*CapabilitiesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var DAService_serviceDesc = _DAService_serviceDesc
+var _DAService_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "da.DAService",
+	HandlerType: (*DAServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "MaxBlobSize",
+			Handler:    _DAService_MaxBlobSize_Handler,
+		},
+		{
+			MethodName: "Get",
+			Handler:    _DAService_Get_Handler,
+		},
+		{
+			MethodName: "GetIds",
+			Handler:    _DAService_GetIds_Handler,
+		},
+		{
+			MethodName: "GetProofs",
+			Handler:    _DAService_GetProofs_Handler,
+		},
+		{
+			MethodName: "Commit",
+			Handler:    _DAService_Commit_Handler,
+		},
+		{
+			MethodName: "Submit",
+			Handler:    _DAService_Submit_Handler,
+		},
+		{
+			MethodName: "Validate",
+			Handler:    _DAService_Validate_Handler,
+		},
+		{
+			MethodName: "LatestHeight",
+			Handler:    _DAService_LatestHeight_Handler,
+		},
+		{
+			MethodName: "GetAll",
+			Handler:    _DAService_GetAll_Handler,
+		},
+		{
+			MethodName: "SubmitWithReceipt",
+			Handler:    _DAService_SubmitWithReceipt_Handler,
+		},
+		{
+			MethodName: "GetSubmissionStatus",
+			Handler:    _DAService_GetSubmissionStatus_Handler,
+		},
+		{
+			MethodName: "GasPrice",
+			Handler:    _DAService_GasPrice_Handler,
+		},
+		{
+			MethodName: "GasMultiplier",
+			Handler:    _DAService_GasMultiplier_Handler,
+		},
+		{
+			MethodName: "Capabilities",
+			Handler:    _DAService_Capabilities_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "Subscribe",
+			Handler:       _DAService_Subscribe_Handler,
+			ServerStreams: true,
+		},
+	},
+	Metadata: "da/da.proto",
+}
+
+func (m *Namespace) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Namespace) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Namespace) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
 	_ = l
-	if len(m.Ids) > 0 {
-		for _, e := range m.Ids {
-			l = e.Size()
-			n += 1 + l + sovDa(uint64(l))
+	if len(m.Value) > 0 {
+		i -= len(m.Value)
+		copy(dAtA[i:], m.Value)
+		i = encodeVarintDa(dAtA, i, uint64(len(m.Value)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *Blob) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Blob) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Blob) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Value) > 0 {
+		i -= len(m.Value)
+		copy(dAtA[i:], m.Value)
+		i = encodeVarintDa(dAtA, i, uint64(len(m.Value)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ID) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ID) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ID) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Value) > 0 {
+		i -= len(m.Value)
+		copy(dAtA[i:], m.Value)
+		i = encodeVarintDa(dAtA, i, uint64(len(m.Value)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *Commitment) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Commitment) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Commitment) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Value) > 0 {
+		i -= len(m.Value)
+		copy(dAtA[i:], m.Value)
+		i = encodeVarintDa(dAtA, i, uint64(len(m.Value)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *Proof) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Proof) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Proof) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Value) > 0 {
+		i -= len(m.Value)
+		copy(dAtA[i:], m.Value)
+		i = encodeVarintDa(dAtA, i, uint64(len(m.Value)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *Receipt) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Receipt) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Receipt) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Value) > 0 {
+		i -= len(m.Value)
+		copy(dAtA[i:], m.Value)
+		i = encodeVarintDa(dAtA, i, uint64(len(m.Value)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MaxBlobSizeRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MaxBlobSizeRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MaxBlobSizeRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MaxBlobSizeResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MaxBlobSizeResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MaxBlobSizeResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.MaxBlobSize != 0 {
+		i = encodeVarintDa(dAtA, i, uint64(m.MaxBlobSize))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *GetRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GetRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GetRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Namespace != nil {
+		{
+			size, err := m.Namespace.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintDa(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Ids) > 0 {
+		for iNdEx := len(m.Ids) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Ids[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintDa(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *GetResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GetResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GetResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Blobs) > 0 {
+		for iNdEx := len(m.Blobs) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Blobs[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintDa(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *GetIdsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GetIdsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GetIdsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Namespace != nil {
+		{
+			size, err := m.Namespace.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintDa(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Height != 0 {
+		i = encodeVarintDa(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *GetIdsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GetIdsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GetIdsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Timestamp != nil {
+		{
+			size, err := m.Timestamp.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintDa(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Ids) > 0 {
+		for iNdEx := len(m.Ids) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Ids[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintDa(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *GetProofsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GetProofsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GetProofsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Namespace != nil {
+		{
+			size, err := m.Namespace.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintDa(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Ids) > 0 {
+		for iNdEx := len(m.Ids) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Ids[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintDa(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *GetProofsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GetProofsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GetProofsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Proofs) > 0 {
+		for iNdEx := len(m.Proofs) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Proofs[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintDa(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *CommitRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CommitRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CommitRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Namespace != nil {
+		{
+			size, err := m.Namespace.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintDa(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Blobs) > 0 {
+		for iNdEx := len(m.Blobs) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Blobs[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintDa(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *CommitResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CommitResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CommitResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Commitments) > 0 {
+		for iNdEx := len(m.Commitments) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Commitments[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintDa(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *SubmitRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SubmitRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SubmitRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Options) > 0 {
+		i -= len(m.Options)
+		copy(dAtA[i:], m.Options)
+		i = encodeVarintDa(dAtA, i, uint64(len(m.Options)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.Namespace != nil {
+		{
+			size, err := m.Namespace.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintDa(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.GasPrice != 0 {
+		i -= 8
+		encoding_binary.LittleEndian.PutUint64(dAtA[i:], uint64(math.Float64bits(float64(m.GasPrice))))
+		i--
+		dAtA[i] = 0x11
+	}
+	if len(m.Blobs) > 0 {
+		for iNdEx := len(m.Blobs) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Blobs[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintDa(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *SubmitResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SubmitResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SubmitResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Ids) > 0 {
+		for iNdEx := len(m.Ids) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Ids[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintDa(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ValidateRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ValidateRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ValidateRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Namespace != nil {
+		{
+			size, err := m.Namespace.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintDa(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Proofs) > 0 {
+		for iNdEx := len(m.Proofs) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Proofs[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintDa(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Ids) > 0 {
+		for iNdEx := len(m.Ids) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Ids[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintDa(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ValidateResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ValidateResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ValidateResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Results) > 0 {
+		for iNdEx := len(m.Results) - 1; iNdEx >= 0; iNdEx-- {
+			i--
+			if m.Results[iNdEx] {
+				dAtA[i] = 1
+			} else {
+				dAtA[i] = 0
+			}
+		}
+		i = encodeVarintDa(dAtA, i, uint64(len(m.Results)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *SubscribeRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SubscribeRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SubscribeRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Namespace != nil {
+		{
+			size, err := m.Namespace.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintDa(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *SubscribeResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SubscribeResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SubscribeResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Timestamp != nil {
+		{
+			size, err := m.Timestamp.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintDa(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Ids) > 0 {
+		for iNdEx := len(m.Ids) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Ids[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintDa(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if m.Height != 0 {
+		i = encodeVarintDa(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *LatestHeightRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *LatestHeightRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *LatestHeightRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *LatestHeightResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *LatestHeightResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *LatestHeightResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Height != 0 {
+		i = encodeVarintDa(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *GetAllRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GetAllRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GetAllRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Namespace != nil {
+		{
+			size, err := m.Namespace.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintDa(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Height != 0 {
+		i = encodeVarintDa(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *GetAllResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GetAllResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GetAllResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Timestamp != nil {
+		{
+			size, err := m.Timestamp.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintDa(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Blobs) > 0 {
+		for iNdEx := len(m.Blobs) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Blobs[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintDa(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Ids) > 0 {
+		for iNdEx := len(m.Ids) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Ids[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintDa(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *SubmitWithReceiptResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SubmitWithReceiptResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SubmitWithReceiptResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Receipt != nil {
+		{
+			size, err := m.Receipt.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintDa(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *GetSubmissionStatusRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GetSubmissionStatusRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GetSubmissionStatusRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Receipt != nil {
+		{
+			size, err := m.Receipt.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintDa(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *GetSubmissionStatusResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GetSubmissionStatusResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GetSubmissionStatusResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Error) > 0 {
+		i -= len(m.Error)
+		copy(dAtA[i:], m.Error)
+		i = encodeVarintDa(dAtA, i, uint64(len(m.Error)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Ids) > 0 {
+		for iNdEx := len(m.Ids) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Ids[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintDa(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if m.Height != 0 {
+		i = encodeVarintDa(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.State != 0 {
+		i = encodeVarintDa(dAtA, i, uint64(m.State))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *GasPriceRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GasPriceRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GasPriceRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *GasPriceResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GasPriceResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GasPriceResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.GasPrice != 0 {
+		i -= 8
+		encoding_binary.LittleEndian.PutUint64(dAtA[i:], uint64(math.Float64bits(float64(m.GasPrice))))
+		i--
+		dAtA[i] = 0x9
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *GasMultiplierRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GasMultiplierRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GasMultiplierRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *GasMultiplierResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GasMultiplierResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GasMultiplierResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.GasMultiplier != 0 {
+		i -= 8
+		encoding_binary.LittleEndian.PutUint64(dAtA[i:], uint64(math.Float64bits(float64(m.GasMultiplier))))
+		i--
+		dAtA[i] = 0x9
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *CapabilitiesRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CapabilitiesRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CapabilitiesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *CapabilitiesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CapabilitiesResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CapabilitiesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Extensions) > 0 {
+		for iNdEx := len(m.Extensions) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Extensions[iNdEx])
+			copy(dAtA[i:], m.Extensions[iNdEx])
+			i = encodeVarintDa(dAtA, i, uint64(len(m.Extensions[iNdEx])))
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	if m.BlockTime != nil {
+		{
+			size, err := m.BlockTime.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintDa(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.MaxBlobSize != 0 {
+		i = encodeVarintDa(dAtA, i, uint64(m.MaxBlobSize))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.ProofType) > 0 {
+		i -= len(m.ProofType)
+		copy(dAtA[i:], m.ProofType)
+		i = encodeVarintDa(dAtA, i, uint64(len(m.ProofType)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.OptionsSchema) > 0 {
+		i -= len(m.OptionsSchema)
+		copy(dAtA[i:], m.OptionsSchema)
+		i = encodeVarintDa(dAtA, i, uint64(len(m.OptionsSchema)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.NamespaceSize != 0 {
+		i = encodeVarintDa(dAtA, i, uint64(m.NamespaceSize))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ErrorDetails) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ErrorDetails) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ErrorDetails) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Code != 0 {
+		i = encodeVarintDa(dAtA, i, uint64(m.Code))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintDa(dAtA []byte, offset int, v uint64) int {
+	offset -= sovDa(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *Namespace) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Value)
+	if l > 0 {
+		n += 1 + l + sovDa(uint64(l))
+	}
+	return n
+}
+
+func (m *Blob) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Value)
+	if l > 0 {
+		n += 1 + l + sovDa(uint64(l))
+	}
+	return n
+}
+
+func (m *ID) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Value)
+	if l > 0 {
+		n += 1 + l + sovDa(uint64(l))
+	}
+	return n
+}
+
+func (m *Commitment) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Value)
+	if l > 0 {
+		n += 1 + l + sovDa(uint64(l))
+	}
+	return n
+}
+
+func (m *Proof) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Value)
+	if l > 0 {
+		n += 1 + l + sovDa(uint64(l))
+	}
+	return n
+}
+
+func (m *Receipt) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Value)
+	if l > 0 {
+		n += 1 + l + sovDa(uint64(l))
+	}
+	return n
+}
+
+func (m *MaxBlobSizeRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MaxBlobSizeResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.MaxBlobSize != 0 {
+		n += 1 + sovDa(uint64(m.MaxBlobSize))
+	}
+	return n
+}
+
+func (m *GetRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Ids) > 0 {
+		for _, e := range m.Ids {
+			l = e.Size()
+			n += 1 + l + sovDa(uint64(l))
+		}
+	}
+	if m.Namespace != nil {
+		l = m.Namespace.Size()
+		n += 1 + l + sovDa(uint64(l))
+	}
+	return n
+}
+
+func (m *GetResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Blobs) > 0 {
+		for _, e := range m.Blobs {
+			l = e.Size()
+			n += 1 + l + sovDa(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *GetIdsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Height != 0 {
+		n += 1 + sovDa(uint64(m.Height))
+	}
+	if m.Namespace != nil {
+		l = m.Namespace.Size()
+		n += 1 + l + sovDa(uint64(l))
+	}
+	return n
+}
+
+func (m *GetIdsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Ids) > 0 {
+		for _, e := range m.Ids {
+			l = e.Size()
+			n += 1 + l + sovDa(uint64(l))
+		}
+	}
+	if m.Timestamp != nil {
+		l = m.Timestamp.Size()
+		n += 1 + l + sovDa(uint64(l))
+	}
+	return n
+}
+
+func (m *GetProofsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Ids) > 0 {
+		for _, e := range m.Ids {
+			l = e.Size()
+			n += 1 + l + sovDa(uint64(l))
+		}
+	}
+	if m.Namespace != nil {
+		l = m.Namespace.Size()
+		n += 1 + l + sovDa(uint64(l))
+	}
+	return n
+}
+
+func (m *GetProofsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Proofs) > 0 {
+		for _, e := range m.Proofs {
+			l = e.Size()
+			n += 1 + l + sovDa(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *CommitRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Blobs) > 0 {
+		for _, e := range m.Blobs {
+			l = e.Size()
+			n += 1 + l + sovDa(uint64(l))
+		}
+	}
+	if m.Namespace != nil {
+		l = m.Namespace.Size()
+		n += 1 + l + sovDa(uint64(l))
+	}
+	return n
+}
+
+func (m *CommitResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Commitments) > 0 {
+		for _, e := range m.Commitments {
+			l = e.Size()
+			n += 1 + l + sovDa(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *SubmitRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Blobs) > 0 {
+		for _, e := range m.Blobs {
+			l = e.Size()
+			n += 1 + l + sovDa(uint64(l))
+		}
+	}
+	if m.GasPrice != 0 {
+		n += 9
+	}
+	if m.Namespace != nil {
+		l = m.Namespace.Size()
+		n += 1 + l + sovDa(uint64(l))
+	}
+	l = len(m.Options)
+	if l > 0 {
+		n += 1 + l + sovDa(uint64(l))
+	}
+	return n
+}
+
+func (m *SubmitResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Ids) > 0 {
+		for _, e := range m.Ids {
+			l = e.Size()
+			n += 1 + l + sovDa(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *ValidateRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Ids) > 0 {
+		for _, e := range m.Ids {
+			l = e.Size()
+			n += 1 + l + sovDa(uint64(l))
+		}
+	}
+	if len(m.Proofs) > 0 {
+		for _, e := range m.Proofs {
+			l = e.Size()
+			n += 1 + l + sovDa(uint64(l))
+		}
+	}
+	if m.Namespace != nil {
+		l = m.Namespace.Size()
+		n += 1 + l + sovDa(uint64(l))
+	}
+	return n
+}
+
+func (m *ValidateResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Results) > 0 {
+		n += 1 + sovDa(uint64(len(m.Results))) + len(m.Results)*1
+	}
+	return n
+}
+
+func (m *SubscribeRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Namespace != nil {
+		l = m.Namespace.Size()
+		n += 1 + l + sovDa(uint64(l))
+	}
+	return n
+}
+
+func (m *SubscribeResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Height != 0 {
+		n += 1 + sovDa(uint64(m.Height))
+	}
+	if len(m.Ids) > 0 {
+		for _, e := range m.Ids {
+			l = e.Size()
+			n += 1 + l + sovDa(uint64(l))
+		}
+	}
+	if m.Timestamp != nil {
+		l = m.Timestamp.Size()
+		n += 1 + l + sovDa(uint64(l))
+	}
+	return n
+}
+
+func (m *LatestHeightRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *LatestHeightResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Height != 0 {
+		n += 1 + sovDa(uint64(m.Height))
+	}
+	return n
+}
+
+func (m *GetAllRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Height != 0 {
+		n += 1 + sovDa(uint64(m.Height))
+	}
+	if m.Namespace != nil {
+		l = m.Namespace.Size()
+		n += 1 + l + sovDa(uint64(l))
+	}
+	return n
+}
+
+func (m *GetAllResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Ids) > 0 {
+		for _, e := range m.Ids {
+			l = e.Size()
+			n += 1 + l + sovDa(uint64(l))
+		}
+	}
+	if len(m.Blobs) > 0 {
+		for _, e := range m.Blobs {
+			l = e.Size()
+			n += 1 + l + sovDa(uint64(l))
+		}
+	}
+	if m.Timestamp != nil {
+		l = m.Timestamp.Size()
+		n += 1 + l + sovDa(uint64(l))
+	}
+	return n
+}
+
+func (m *SubmitWithReceiptResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Receipt != nil {
+		l = m.Receipt.Size()
+		n += 1 + l + sovDa(uint64(l))
+	}
+	return n
+}
+
+func (m *GetSubmissionStatusRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Receipt != nil {
+		l = m.Receipt.Size()
+		n += 1 + l + sovDa(uint64(l))
+	}
+	return n
+}
+
+func (m *GetSubmissionStatusResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.State != 0 {
+		n += 1 + sovDa(uint64(m.State))
+	}
+	if m.Height != 0 {
+		n += 1 + sovDa(uint64(m.Height))
+	}
+	if len(m.Ids) > 0 {
+		for _, e := range m.Ids {
+			l = e.Size()
+			n += 1 + l + sovDa(uint64(l))
+		}
+	}
+	l = len(m.Error)
+	if l > 0 {
+		n += 1 + l + sovDa(uint64(l))
+	}
+	return n
+}
+
+func (m *GasPriceRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *GasPriceResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.GasPrice != 0 {
+		n += 9
+	}
+	return n
+}
+
+func (m *GasMultiplierRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *GasMultiplierResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.GasMultiplier != 0 {
+		n += 9
+	}
+	return n
+}
+
+func (m *CapabilitiesRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *CapabilitiesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.NamespaceSize != 0 {
+		n += 1 + sovDa(uint64(m.NamespaceSize))
+	}
+	l = len(m.OptionsSchema)
+	if l > 0 {
+		n += 1 + l + sovDa(uint64(l))
+	}
+	l = len(m.ProofType)
+	if l > 0 {
+		n += 1 + l + sovDa(uint64(l))
+	}
+	if m.MaxBlobSize != 0 {
+		n += 1 + sovDa(uint64(m.MaxBlobSize))
+	}
+	if m.BlockTime != nil {
+		l = m.BlockTime.Size()
+		n += 1 + l + sovDa(uint64(l))
+	}
+	if len(m.Extensions) > 0 {
+		for _, s := range m.Extensions {
+			l = len(s)
+			n += 1 + l + sovDa(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *ErrorDetails) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Code != 0 {
+		n += 1 + sovDa(uint64(m.Code))
+	}
+	return n
+}
+
+func sovDa(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozDa(x uint64) (n int) {
+	return sovDa(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *Namespace) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowDa
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Namespace: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Namespace: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDa
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthDa
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDa
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Value = append(m.Value[:0], dAtA[iNdEx:postIndex]...)
+			if m.Value == nil {
+				m.Value = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipDa(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthDa
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Blob) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowDa
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Blob: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Blob: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDa
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthDa
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDa
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Value = append(m.Value[:0], dAtA[iNdEx:postIndex]...)
+			if m.Value == nil {
+				m.Value = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipDa(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthDa
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ID) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowDa
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ID: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ID: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDa
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthDa
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDa
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Value = append(m.Value[:0], dAtA[iNdEx:postIndex]...)
+			if m.Value == nil {
+				m.Value = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipDa(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthDa
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Commitment) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowDa
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Commitment: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Commitment: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDa
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthDa
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDa
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Value = append(m.Value[:0], dAtA[iNdEx:postIndex]...)
+			if m.Value == nil {
+				m.Value = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipDa(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthDa
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Proof) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowDa
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Proof: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Proof: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDa
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthDa
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDa
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Value = append(m.Value[:0], dAtA[iNdEx:postIndex]...)
+			if m.Value == nil {
+				m.Value = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipDa(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthDa
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Receipt) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowDa
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Receipt: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Receipt: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDa
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthDa
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDa
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Value = append(m.Value[:0], dAtA[iNdEx:postIndex]...)
+			if m.Value == nil {
+				m.Value = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipDa(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthDa
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MaxBlobSizeRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowDa
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MaxBlobSizeRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MaxBlobSizeRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipDa(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthDa
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MaxBlobSizeResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowDa
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MaxBlobSizeResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MaxBlobSizeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxBlobSize", wireType)
+			}
+			m.MaxBlobSize = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDa
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxBlobSize |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipDa(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthDa
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GetRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowDa
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GetRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GetRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Ids", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDa
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthDa
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthDa
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Ids = append(m.Ids, &ID{})
+			if err := m.Ids[len(m.Ids)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Namespace", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDa
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthDa
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthDa
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Namespace == nil {
+				m.Namespace = &Namespace{}
+			}
+			if err := m.Namespace.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipDa(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthDa
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GetResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowDa
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GetResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GetResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Blobs", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDa
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthDa
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthDa
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Blobs = append(m.Blobs, &Blob{})
+			if err := m.Blobs[len(m.Blobs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipDa(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthDa
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GetIdsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowDa
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GetIdsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GetIdsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDa
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Namespace", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDa
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthDa
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthDa
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Namespace == nil {
+				m.Namespace = &Namespace{}
+			}
+			if err := m.Namespace.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipDa(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthDa
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GetIdsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowDa
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GetIdsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GetIdsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Ids", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDa
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthDa
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthDa
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Ids = append(m.Ids, &ID{})
+			if err := m.Ids[len(m.Ids)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Timestamp", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDa
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthDa
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthDa
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Timestamp == nil {
+				m.Timestamp = &types.Timestamp{}
+			}
+			if err := m.Timestamp.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipDa(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthDa
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GetProofsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowDa
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GetProofsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GetProofsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Ids", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDa
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthDa
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthDa
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Ids = append(m.Ids, &ID{})
+			if err := m.Ids[len(m.Ids)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Namespace", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDa
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthDa
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthDa
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Namespace == nil {
+				m.Namespace = &Namespace{}
+			}
+			if err := m.Namespace.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipDa(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthDa
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GetProofsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowDa
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GetProofsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GetProofsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Proofs", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDa
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthDa
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthDa
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Proofs = append(m.Proofs, &Proof{})
+			if err := m.Proofs[len(m.Proofs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipDa(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthDa
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *CommitRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowDa
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CommitRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CommitRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Blobs", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDa
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthDa
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthDa
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Blobs = append(m.Blobs, &Blob{})
+			if err := m.Blobs[len(m.Blobs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Namespace", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDa
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthDa
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthDa
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Namespace == nil {
+				m.Namespace = &Namespace{}
+			}
+			if err := m.Namespace.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipDa(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthDa
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
 		}
 	}
-	return n
-}
 
-func (m *ValidateRequest) Size() (n int) {
-	if m == nil {
-		return 0
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	var l int
-	_ = l
-	if len(m.Ids) > 0 {
-		for _, e := range m.Ids {
-			l = e.Size()
-			n += 1 + l + sovDa(uint64(l))
+	return nil
+}
+func (m *CommitResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowDa
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
 		}
-	}
-	if len(m.Proofs) > 0 {
-		for _, e := range m.Proofs {
-			l = e.Size()
-			n += 1 + l + sovDa(uint64(l))
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CommitResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CommitResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Commitments", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDa
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthDa
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthDa
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Commitments = append(m.Commitments, &Commitment{})
+			if err := m.Commitments[len(m.Commitments)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipDa(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthDa
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
 		}
 	}
-	if m.Namespace != nil {
-		l = m.Namespace.Size()
-		n += 1 + l + sovDa(uint64(l))
-	}
-	return n
-}
-
-func (m *ValidateResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if len(m.Results) > 0 {
-		n += 1 + sovDa(uint64(len(m.Results))) + len(m.Results)*1
-	}
-	return n
-}
 
-func (m *ErrorDetails) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Code != 0 {
-		n += 1 + sovDa(uint64(m.Code))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	return n
-}
-
-func sovDa(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozDa(x uint64) (n int) {
-	return sovDa(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	return nil
 }
-func (m *Namespace) Unmarshal(dAtA []byte) error {
+func (m *SubmitRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2566,19 +6082,100 @@ func (m *Namespace) Unmarshal(dAtA []byte) error {
 			if b < 0x80 {
 				break
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: Namespace: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Namespace: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SubmitRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SubmitRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Blobs", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDa
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthDa
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthDa
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Blobs = append(m.Blobs, &Blob{})
+			if err := m.Blobs[len(m.Blobs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 1 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GasPrice", wireType)
+			}
+			var v uint64
+			if (iNdEx + 8) > l {
+				return io.ErrUnexpectedEOF
+			}
+			v = uint64(encoding_binary.LittleEndian.Uint64(dAtA[iNdEx:]))
+			iNdEx += 8
+			m.GasPrice = float64(math.Float64frombits(v))
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Namespace", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDa
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthDa
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthDa
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Namespace == nil {
+				m.Namespace = &Namespace{}
+			}
+			if err := m.Namespace.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Options", wireType)
 			}
 			var byteLen int
 			for shift := uint(0); ; shift += 7 {
@@ -2605,9 +6202,9 @@ func (m *Namespace) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Value = append(m.Value[:0], dAtA[iNdEx:postIndex]...)
-			if m.Value == nil {
-				m.Value = []byte{}
+			m.Options = append(m.Options[:0], dAtA[iNdEx:postIndex]...)
+			if m.Options == nil {
+				m.Options = []byte{}
 			}
 			iNdEx = postIndex
 		default:
@@ -2631,7 +6228,7 @@ func (m *Namespace) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Blob) Unmarshal(dAtA []byte) error {
+func (m *SubmitResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2654,17 +6251,17 @@ func (m *Blob) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Blob: wiretype end group for non-group")
+			return fmt.Errorf("proto: SubmitResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Blob: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: SubmitResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Ids", wireType)
 			}
-			var byteLen int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowDa
@@ -2674,24 +6271,24 @@ func (m *Blob) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthDa
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthDa
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Value = append(m.Value[:0], dAtA[iNdEx:postIndex]...)
-			if m.Value == nil {
-				m.Value = []byte{}
+			m.Ids = append(m.Ids, &ID{})
+			if err := m.Ids[len(m.Ids)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
 			iNdEx = postIndex
 		default:
@@ -2715,7 +6312,7 @@ func (m *Blob) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ID) Unmarshal(dAtA []byte) error {
+func (m *ValidateRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2738,17 +6335,17 @@ func (m *ID) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ID: wiretype end group for non-group")
+			return fmt.Errorf("proto: ValidateRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ID: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ValidateRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Ids", wireType)
 			}
-			var byteLen int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowDa
@@ -2758,81 +6355,31 @@ func (m *ID) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthDa
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthDa
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Value = append(m.Value[:0], dAtA[iNdEx:postIndex]...)
-			if m.Value == nil {
-				m.Value = []byte{}
-			}
-			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipDa(dAtA[iNdEx:])
-			if err != nil {
+			m.Ids = append(m.Ids, &ID{})
+			if err := m.Ids[len(m.Ids)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthDa
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *Commitment) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowDa
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: Commitment: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Commitment: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			iNdEx = postIndex
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Proofs", wireType)
 			}
-			var byteLen int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowDa
@@ -2842,81 +6389,31 @@ func (m *Commitment) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthDa
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthDa
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Value = append(m.Value[:0], dAtA[iNdEx:postIndex]...)
-			if m.Value == nil {
-				m.Value = []byte{}
-			}
-			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipDa(dAtA[iNdEx:])
-			if err != nil {
+			m.Proofs = append(m.Proofs, &Proof{})
+			if err := m.Proofs[len(m.Proofs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthDa
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *Proof) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowDa
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: Proof: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Proof: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			iNdEx = postIndex
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Namespace", wireType)
 			}
-			var byteLen int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowDa
@@ -2926,24 +6423,26 @@ func (m *Proof) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthDa
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthDa
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Value = append(m.Value[:0], dAtA[iNdEx:postIndex]...)
-			if m.Value == nil {
-				m.Value = []byte{}
+			if m.Namespace == nil {
+				m.Namespace = &Namespace{}
+			}
+			if err := m.Namespace.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
 			iNdEx = postIndex
 		default:
@@ -2967,7 +6466,7 @@ func (m *Proof) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MaxBlobSizeRequest) Unmarshal(dAtA []byte) error {
+func (m *ValidateResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2986,16 +6485,86 @@ func (m *MaxBlobSizeRequest) Unmarshal(dAtA []byte) error {
 			if b < 0x80 {
 				break
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: MaxBlobSizeRequest: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MaxBlobSizeRequest: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ValidateResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ValidateResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType == 0 {
+				var v int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowDa
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.Results = append(m.Results, bool(v != 0))
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowDa
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthDa
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthDa
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				elementCount = packedLen
+				if elementCount != 0 && len(m.Results) == 0 {
+					m.Results = make([]bool, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v int
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowDa
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= int(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.Results = append(m.Results, bool(v != 0))
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field Results", wireType)
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipDa(dAtA[iNdEx:])
@@ -3017,7 +6586,7 @@ func (m *MaxBlobSizeRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MaxBlobSizeResponse) Unmarshal(dAtA []byte) error {
+func (m *SubscribeRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3040,17 +6609,17 @@ func (m *MaxBlobSizeResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MaxBlobSizeResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: SubscribeRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MaxBlobSizeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: SubscribeRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MaxBlobSize", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Namespace", wireType)
 			}
-			m.MaxBlobSize = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowDa
@@ -3060,11 +6629,28 @@ func (m *MaxBlobSizeResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.MaxBlobSize |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if msglen < 0 {
+				return ErrInvalidLengthDa
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthDa
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Namespace == nil {
+				m.Namespace = &Namespace{}
+			}
+			if err := m.Namespace.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipDa(dAtA[iNdEx:])
@@ -3086,7 +6672,7 @@ func (m *MaxBlobSizeResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *GetRequest) Unmarshal(dAtA []byte) error {
+func (m *SubscribeResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3109,13 +6695,32 @@ func (m *GetRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: GetRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: SubscribeResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GetRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: SubscribeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDa
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Ids", wireType)
 			}
@@ -3149,9 +6754,9 @@ func (m *GetRequest) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
-		case 2:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Namespace", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Timestamp", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -3178,10 +6783,10 @@ func (m *GetRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Namespace == nil {
-				m.Namespace = &Namespace{}
+			if m.Timestamp == nil {
+				m.Timestamp = &types.Timestamp{}
 			}
-			if err := m.Namespace.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Timestamp.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -3206,7 +6811,7 @@ func (m *GetRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *GetResponse) Unmarshal(dAtA []byte) error {
+func (m *LatestHeightRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3229,17 +6834,67 @@ func (m *GetResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: GetResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: LatestHeightRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GetResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: LatestHeightRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipDa(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthDa
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *LatestHeightResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowDa
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: LatestHeightResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: LatestHeightResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Blobs", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
 			}
-			var msglen int
+			m.Height = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowDa
@@ -3249,26 +6904,11 @@ func (m *GetResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.Height |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthDa
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthDa
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Blobs = append(m.Blobs, &Blob{})
-			if err := m.Blobs[len(m.Blobs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipDa(dAtA[iNdEx:])
@@ -3290,7 +6930,7 @@ func (m *GetResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *GetIdsRequest) Unmarshal(dAtA []byte) error {
+func (m *GetAllRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3313,10 +6953,10 @@ func (m *GetIdsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: GetIdsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: GetAllRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GetIdsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: GetAllRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -3395,7 +7035,7 @@ func (m *GetIdsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *GetIdsResponse) Unmarshal(dAtA []byte) error {
+func (m *GetAllResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3418,10 +7058,10 @@ func (m *GetIdsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: GetIdsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: GetAllResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GetIdsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: GetAllResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -3459,6 +7099,40 @@ func (m *GetIdsResponse) Unmarshal(dAtA []byte) error {
 			}
 			iNdEx = postIndex
 		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Blobs", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDa
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthDa
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthDa
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Blobs = append(m.Blobs, &Blob{})
+			if err := m.Blobs[len(m.Blobs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Timestamp", wireType)
 			}
@@ -3515,7 +7189,7 @@ func (m *GetIdsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *GetProofsRequest) Unmarshal(dAtA []byte) error {
+func (m *SubmitWithReceiptResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3538,49 +7212,15 @@ func (m *GetProofsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: GetProofsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: SubmitWithReceiptResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GetProofsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: SubmitWithReceiptResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Ids", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowDa
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthDa
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthDa
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Ids = append(m.Ids, &ID{})
-			if err := m.Ids[len(m.Ids)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Namespace", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Receipt", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -3607,10 +7247,10 @@ func (m *GetProofsRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Namespace == nil {
-				m.Namespace = &Namespace{}
+			if m.Receipt == nil {
+				m.Receipt = &Receipt{}
 			}
-			if err := m.Namespace.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Receipt.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -3635,7 +7275,7 @@ func (m *GetProofsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *GetProofsResponse) Unmarshal(dAtA []byte) error {
+func (m *GetSubmissionStatusRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3658,15 +7298,15 @@ func (m *GetProofsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: GetProofsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: GetSubmissionStatusRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GetProofsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: GetSubmissionStatusRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Proofs", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Receipt", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -3693,8 +7333,10 @@ func (m *GetProofsResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Proofs = append(m.Proofs, &Proof{})
-			if err := m.Proofs[len(m.Proofs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.Receipt == nil {
+				m.Receipt = &Receipt{}
+			}
+			if err := m.Receipt.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -3719,7 +7361,7 @@ func (m *GetProofsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *CommitRequest) Unmarshal(dAtA []byte) error {
+func (m *GetSubmissionStatusResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3742,15 +7384,53 @@ func (m *CommitRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: CommitRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: GetSubmissionStatusResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: CommitRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: GetSubmissionStatusResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field State", wireType)
+			}
+			m.State = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDa
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.State |= SubmissionState(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDa
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Blobs", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Ids", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -3777,16 +7457,16 @@ func (m *CommitRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Blobs = append(m.Blobs, &Blob{})
-			if err := m.Blobs[len(m.Blobs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Ids = append(m.Ids, &ID{})
+			if err := m.Ids[len(m.Ids)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 2:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Namespace", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Error", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowDa
@@ -3796,27 +7476,23 @@ func (m *CommitRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthDa
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthDa
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Namespace == nil {
-				m.Namespace = &Namespace{}
-			}
-			if err := m.Namespace.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Error = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -3839,7 +7515,7 @@ func (m *CommitRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *CommitResponse) Unmarshal(dAtA []byte) error {
+func (m *GasPriceRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3862,46 +7538,12 @@ func (m *CommitResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: CommitResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: GasPriceRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: CommitResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: GasPriceRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
-		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Commitments", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowDa
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthDa
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthDa
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Commitments = append(m.Commitments, &Commitment{})
-			if err := m.Commitments[len(m.Commitments)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipDa(dAtA[iNdEx:])
@@ -3923,7 +7565,7 @@ func (m *CommitResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *SubmitRequest) Unmarshal(dAtA []byte) error {
+func (m *GasPriceResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3946,47 +7588,13 @@ func (m *SubmitRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: SubmitRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: GasPriceResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: SubmitRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: GasPriceResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Blobs", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowDa
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthDa
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthDa
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Blobs = append(m.Blobs, &Blob{})
-			if err := m.Blobs[len(m.Blobs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 2:
 			if wireType != 1 {
 				return fmt.Errorf("proto: wrong wireType = %d for field GasPrice", wireType)
 			}
@@ -3997,76 +7605,117 @@ func (m *SubmitRequest) Unmarshal(dAtA []byte) error {
 			v = uint64(encoding_binary.LittleEndian.Uint64(dAtA[iNdEx:]))
 			iNdEx += 8
 			m.GasPrice = float64(math.Float64frombits(v))
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Namespace", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowDa
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipDa(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			if msglen < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthDa
 			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthDa
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
 			}
-			if postIndex > l {
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GasMultiplierRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowDa
+			}
+			if iNdEx >= l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Namespace == nil {
-				m.Namespace = &Namespace{}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
 			}
-			if err := m.Namespace.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GasMultiplierRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GasMultiplierRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipDa(dAtA[iNdEx:])
+			if err != nil {
 				return err
 			}
-			iNdEx = postIndex
-		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Options", wireType)
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthDa
 			}
-			var byteLen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowDa
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				byteLen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
 			}
-			if byteLen < 0 {
-				return ErrInvalidLengthDa
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GasMultiplierResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowDa
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
 			}
-			postIndex := iNdEx + byteLen
-			if postIndex < 0 {
-				return ErrInvalidLengthDa
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GasMultiplierResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GasMultiplierResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 1 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GasMultiplier", wireType)
 			}
-			if postIndex > l {
+			var v uint64
+			if (iNdEx + 8) > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Options = append(m.Options[:0], dAtA[iNdEx:postIndex]...)
-			if m.Options == nil {
-				m.Options = []byte{}
-			}
-			iNdEx = postIndex
+			v = uint64(encoding_binary.LittleEndian.Uint64(dAtA[iNdEx:]))
+			iNdEx += 8
+			m.GasMultiplier = float64(math.Float64frombits(v))
 		default:
 			iNdEx = preIndex
 			skippy, err := skipDa(dAtA[iNdEx:])
@@ -4088,7 +7737,7 @@ func (m *SubmitRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *SubmitResponse) Unmarshal(dAtA []byte) error {
+func (m *CapabilitiesRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4111,46 +7760,12 @@ func (m *SubmitResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: SubmitResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: CapabilitiesRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: SubmitResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: CapabilitiesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
-		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Ids", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowDa
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthDa
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthDa
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Ids = append(m.Ids, &ID{})
-			if err := m.Ids[len(m.Ids)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipDa(dAtA[iNdEx:])
@@ -4172,7 +7787,7 @@ func (m *SubmitResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ValidateRequest) Unmarshal(dAtA []byte) error {
+func (m *CapabilitiesResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4195,17 +7810,36 @@ func (m *ValidateRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ValidateRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: CapabilitiesResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ValidateRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: CapabilitiesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NamespaceSize", wireType)
+			}
+			m.NamespaceSize = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDa
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.NamespaceSize |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Ids", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field OptionsSchema", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowDa
@@ -4215,31 +7849,29 @@ func (m *ValidateRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthDa
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthDa
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Ids = append(m.Ids, &ID{})
-			if err := m.Ids[len(m.Ids)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.OptionsSchema = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Proofs", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ProofType", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowDa
@@ -4249,29 +7881,46 @@ func (m *ValidateRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthDa
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthDa
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Proofs = append(m.Proofs, &Proof{})
-			if err := m.Proofs[len(m.Proofs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.ProofType = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 3:
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxBlobSize", wireType)
+			}
+			m.MaxBlobSize = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDa
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxBlobSize |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Namespace", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockTime", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -4298,133 +7947,45 @@ func (m *ValidateRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Namespace == nil {
-				m.Namespace = &Namespace{}
+			if m.BlockTime == nil {
+				m.BlockTime = &types.Duration{}
 			}
-			if err := m.Namespace.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.BlockTime.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipDa(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthDa
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *ValidateResponse) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowDa
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Extensions", wireType)
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: ValidateResponse: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ValidateResponse: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
-			if wireType == 0 {
-				var v int
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowDa
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					v |= int(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				m.Results = append(m.Results, bool(v != 0))
-			} else if wireType == 2 {
-				var packedLen int
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowDa
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					packedLen |= int(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				if packedLen < 0 {
-					return ErrInvalidLengthDa
-				}
-				postIndex := iNdEx + packedLen
-				if postIndex < 0 {
-					return ErrInvalidLengthDa
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDa
 				}
-				if postIndex > l {
+				if iNdEx >= l {
 					return io.ErrUnexpectedEOF
 				}
-				var elementCount int
-				elementCount = packedLen
-				if elementCount != 0 && len(m.Results) == 0 {
-					m.Results = make([]bool, 0, elementCount)
-				}
-				for iNdEx < postIndex {
-					var v int
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowDa
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						v |= int(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					m.Results = append(m.Results, bool(v != 0))
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
 				}
-			} else {
-				return fmt.Errorf("proto: wrong wireType = %d for field Results", wireType)
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDa
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDa
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Extensions = append(m.Extensions, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipDa(dAtA[iNdEx:])